@@ -21,6 +21,9 @@ func GetAddress() Addresser {
 
 // SetAddress sets custom Address
 func SetAddress(net Addresser) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	address = net
 }
 
@@ -33,28 +36,28 @@ type Addresser interface {
 // Address struct
 type Address struct{}
 
-func (i Address) latitute() float32 {
-	return (rand.Float32() * 180) - 90
+func (i Address) latitute(r *rand.Rand) float32 {
+	return (r.Float32() * 180) - 90
 }
 
 // Latitude sets latitude of the address
 func (i Address) Latitude(ctx context.Context, v reflect.Value) (interface{}, error) {
 	kind := v.Kind()
-	val := i.latitute()
+	val := i.latitute(RandFromContext(ctx))
 	if kind == reflect.Float32 {
 		return val, nil
 	}
 	return float64(val), nil
 }
 
-func (i Address) longitude() float32 {
-	return (rand.Float32() * 360) - 180
+func (i Address) longitude(r *rand.Rand) float32 {
+	return (r.Float32() * 360) - 180
 }
 
 // Longitude sets longitude of the address
 func (i Address) Longitude(ctx context.Context, v reflect.Value) (interface{}, error) {
 	kind := v.Kind()
-	val := i.longitude()
+	val := i.longitude(RandFromContext(ctx))
 	if kind == reflect.Float32 {
 		return val, nil
 	}
@@ -64,11 +67,11 @@ func (i Address) Longitude(ctx context.Context, v reflect.Value) (interface{}, e
 // Longitude get fake longitude randomly
 func Longitude() float64 {
 	address := Address{}
-	return float64(address.longitude())
+	return float64(address.longitude(globalRand))
 }
 
 // Latitude get fake latitude randomly
 func Latitude() float64 {
 	address := Address{}
-	return float64(address.latitute())
+	return float64(address.latitute(globalRand))
 }
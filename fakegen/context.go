@@ -0,0 +1,122 @@
+package fakegen
+
+import (
+	"context"
+	"math/rand"
+	"reflect"
+	"time"
+)
+
+type randKey struct{}
+
+type referenceTimeKey struct{}
+
+type fieldPathKey struct{}
+
+type generatorKey struct{}
+
+// RandFromContext returns the random source FakeData attached to ctx. Custom providers should draw
+// from it so their values are reproducible with the generator's seed. Outside of FakeData it
+// returns a source backed by the package level math/rand functions.
+func RandFromContext(ctx context.Context) *rand.Rand {
+	if ctx != nil {
+		if r, ok := ctx.Value(randKey{}).(*rand.Rand); ok {
+			return r
+		}
+	}
+	return globalRand
+}
+
+// ReferenceTimeFromContext returns the instant time based values are generated relative to,
+// falling back to the current time outside of FakeData.
+func ReferenceTimeFromContext(ctx context.Context) time.Time {
+	if ctx != nil {
+		if t, ok := ctx.Value(referenceTimeKey{}).(time.Time); ok {
+			return t
+		}
+	}
+	return time.Now()
+}
+
+// FieldPathFromContext returns the dot separated path of struct field names leading to the value
+// being generated, e.g. "Page.PageSize". It is empty for the top level value.
+func FieldPathFromContext(ctx context.Context) string {
+	if ctx != nil {
+		if path, ok := ctx.Value(fieldPathKey{}).(string); ok {
+			return path
+		}
+	}
+	return ""
+}
+
+// withFieldPath returns a copy of ctx whose field path is extended by field.
+func withFieldPath(ctx context.Context, field string) context.Context {
+	if path := FieldPathFromContext(ctx); path != "" {
+		field = path + "." + field
+	}
+	return context.WithValue(ctx, fieldPathKey{}, field)
+}
+
+type typeChainKey struct{}
+
+// typeChain records the struct types currently being generated, innermost first.
+type typeChain struct {
+	typ    reflect.Type
+	parent *typeChain
+}
+
+// withStructType returns a copy of ctx recording that a value of type t is being generated.
+func withStructType(ctx context.Context, t reflect.Type) context.Context {
+	parent, _ := ctx.Value(typeChainKey{}).(*typeChain)
+	return context.WithValue(ctx, typeChainKey{}, &typeChain{typ: t, parent: parent})
+}
+
+// structTypeDepth returns how many times t is already being generated further up the value graph.
+func structTypeDepth(ctx context.Context, t reflect.Type) int {
+	depth := 0
+	chain, _ := ctx.Value(typeChainKey{}).(*typeChain)
+	for ; chain != nil; chain = chain.parent {
+		if chain.typ == t {
+			depth++
+		}
+	}
+	return depth
+}
+
+type fieldTagsKey struct{}
+
+// taggedField records the tags of the field being generated, for the options like nullable or size
+// that apply to the value of the field itself but not to its elements.
+type taggedField struct {
+	path string
+	typ  reflect.Type
+	tags structTag
+}
+
+// withFieldTags returns a copy of ctx recording the tags of the field being generated, of type t.
+func withFieldTags(ctx context.Context, t reflect.Type, tags structTag) context.Context {
+	return context.WithValue(ctx, fieldTagsKey{}, taggedField{path: FieldPathFromContext(ctx), typ: t, tags: tags})
+}
+
+// fieldTagsFromContext returns the tags of the field being generated when the value of type t is
+// the value of the field itself.
+func fieldTagsFromContext(ctx context.Context, t reflect.Type) (structTag, bool) {
+	field, ok := ctx.Value(fieldTagsKey{}).(taggedField)
+	if !ok || field.typ != t || field.path != FieldPathFromContext(ctx) {
+		return structTag{}, false
+	}
+	return field.tags, true
+}
+
+type mapKeyKey struct{}
+
+// withMapKey returns a copy of ctx recording that a map key is being generated, whose interface
+// values must be hashable.
+func withMapKey(ctx context.Context) context.Context {
+	return context.WithValue(ctx, mapKeyKey{}, true)
+}
+
+func isMapKey(ctx context.Context) bool {
+	key, _ := ctx.Value(mapKeyKey{}).(bool)
+	return key
+}
@@ -636,6 +636,9 @@ func GetDateTimer() DateTimer {
 
 // SetDateTimer sets custom date time
 func SetDateTimer(d DateTimer) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	date = d
 }
 
@@ -643,8 +646,8 @@ func SetDateTimer(d DateTimer) {
 type DateTime struct {
 }
 
-func (d DateTime) unixtime() int64 {
-	return RandomUnixTime()
+func (d DateTime) unixtime(r *rand.Rand, now time.Time) int64 {
+	return randomUnixTime(r, now)
 }
 
 // UnixTime get unix time
@@ -652,7 +655,7 @@ func (d DateTime) UnixTime(ctx context.Context, v reflect.Value) (interface{}, e
 	kind := v.Kind()
 	var val int64
 	if kind == reflect.Int64 {
-		val = d.unixtime()
+		val = d.unixtime(RandFromContext(ctx), ReferenceTimeFromContext(ctx))
 	} else {
 		val = 0
 	}
@@ -663,160 +666,169 @@ func (d DateTime) UnixTime(ctx context.Context, v reflect.Value) (interface{}, e
 // UnixTime get unix time randomly
 func UnixTime() int64 {
 	datetime := DateTime{}
-	return datetime.unixtime()
+	return datetime.unixtime(globalRand, time.Now())
 }
 
-func (d DateTime) date() string {
-	return time.Unix(RandomUnixTime(), 0).Format(BaseDateFormat)
+func (d DateTime) date(r *rand.Rand, now time.Time) string {
+	return time.Unix(randomUnixTime(r, now), 0).In(now.Location()).Format(BaseDateFormat)
 }
 
 // Date formats DateTime using example BaseDateFormat const
 func (d DateTime) Date(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return d.date(), nil
+	return d.date(RandFromContext(ctx), ReferenceTimeFromContext(ctx)), nil
 }
 
 // Date get fake date in string randomly
 func Date() string {
 	datetime := DateTime{}
-	return datetime.date()
+	return datetime.date(globalRand, time.Now())
 }
 
-func (d DateTime) time() string {
-	return time.Unix(RandomUnixTime(), 0).Format(TimeFormat)
+func (d DateTime) time(r *rand.Rand, now time.Time) string {
+	return time.Unix(randomUnixTime(r, now), 0).In(now.Location()).Format(TimeFormat)
 }
 
 // Time formats DateTime using example Time const
 func (d DateTime) Time(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return d.time(), nil
+	return d.time(RandFromContext(ctx), ReferenceTimeFromContext(ctx)), nil
 }
 
 // TimeString get time randomly in string format
 func TimeString() string {
 	datetime := DateTime{}
-	return datetime.time()
+	return datetime.time(globalRand, time.Now())
 }
 
-func (d DateTime) monthName() string {
-	return time.Unix(RandomUnixTime(), 0).Format(MonthFormat)
+func (d DateTime) monthName(r *rand.Rand, now time.Time) string {
+	return time.Unix(randomUnixTime(r, now), 0).In(now.Location()).Format(MonthFormat)
 }
 
 // MonthName formats DateTime using example Month const
 func (d DateTime) MonthName(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return d.monthName(), nil
+	return d.monthName(RandFromContext(ctx), ReferenceTimeFromContext(ctx)), nil
 }
 
 // MonthName get month name randomly in string format
 func MonthName() string {
 	datetime := DateTime{}
-	return datetime.monthName()
+	return datetime.monthName(globalRand, time.Now())
 }
 
-func (d DateTime) year() string {
-	return time.Unix(RandomUnixTime(), 0).Format(YearFormat)
+func (d DateTime) year(r *rand.Rand, now time.Time) string {
+	return time.Unix(randomUnixTime(r, now), 0).In(now.Location()).Format(YearFormat)
 }
 
 // Year formats DateTime using example Year const
 func (d DateTime) Year(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return d.year(), nil
+	return d.year(RandFromContext(ctx), ReferenceTimeFromContext(ctx)), nil
 }
 
 // YearString get year randomly in string format
 func YearString() string {
 	datetime := DateTime{}
-	return datetime.year()
+	return datetime.year(globalRand, time.Now())
 }
 
-func (d DateTime) dayOfWeek() string {
-	return time.Unix(RandomUnixTime(), 0).Format(DayFormat)
+func (d DateTime) dayOfWeek(r *rand.Rand, now time.Time) string {
+	return time.Unix(randomUnixTime(r, now), 0).In(now.Location()).Format(DayFormat)
 }
 
 // DayOfWeek formats DateTime using example Day const
 func (d DateTime) DayOfWeek(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return d.dayOfWeek(), nil
+	return d.dayOfWeek(RandFromContext(ctx), ReferenceTimeFromContext(ctx)), nil
 }
 
 // DayOfWeek get day of week randomly in string format
 func DayOfWeek() string {
 	datetime := DateTime{}
-	return datetime.dayOfWeek()
+	return datetime.dayOfWeek(globalRand, time.Now())
 }
 
-func (d DateTime) dayOfMonth() string {
-	return time.Unix(RandomUnixTime(), 0).Format(DayOfMonthFormat)
+func (d DateTime) dayOfMonth(r *rand.Rand, now time.Time) string {
+	return time.Unix(randomUnixTime(r, now), 0).In(now.Location()).Format(DayOfMonthFormat)
 }
 
 // DayOfMonth formats DateTime using example DayOfMonth const
 func (d DateTime) DayOfMonth(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return d.dayOfMonth(), nil
+	return d.dayOfMonth(RandFromContext(ctx), ReferenceTimeFromContext(ctx)), nil
 }
 
 // DayOfMonth get month randomly in string format
 func DayOfMonth() string {
 	datetime := DateTime{}
-	return datetime.dayOfMonth()
+	return datetime.dayOfMonth(globalRand, time.Now())
 }
 
-func (d DateTime) timestamp() string {
-	return time.Unix(RandomUnixTime(), 0).Format(fmt.Sprintf("%s %s", BaseDateFormat, TimeFormat))
+func (d DateTime) timestamp(r *rand.Rand, now time.Time) string {
+	return time.Unix(randomUnixTime(r, now), 0).In(now.Location()).Format(fmt.Sprintf("%s %s", BaseDateFormat, TimeFormat))
 }
 
 // Timestamp formats DateTime using example Timestamp const
 func (d DateTime) Timestamp(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return d.timestamp(), nil
+	return d.timestamp(RandFromContext(ctx), ReferenceTimeFromContext(ctx)), nil
 }
 
 // Timestamp get timestamp randomly in string format: 2006-01-02 15:04:05
 func Timestamp() string {
 	datetime := DateTime{}
-	return datetime.timestamp()
+	return datetime.timestamp(globalRand, time.Now())
 }
 
-func (d DateTime) century() string {
-	return RandomElementFromSliceString(century)
+func (d DateTime) century(r *rand.Rand) string {
+	return randomElementFromSliceString(r, century)
 }
 
 // Century returns a random century
 func (d DateTime) Century(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return d.century(), nil
+	return d.century(RandFromContext(ctx)), nil
 }
 
 // Century get century randomly in string
 func Century() string {
 	datetime := DateTime{}
-	return datetime.century()
+	return datetime.century(globalRand)
 }
 
-func (d DateTime) timezone() string {
-	return RandomElementFromSliceString(timezones)
+func (d DateTime) timezone(r *rand.Rand) string {
+	return randomElementFromSliceString(r, timezones)
 }
 
 // TimeZone returns a random timezone
 func (d DateTime) TimeZone(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return d.timezone(), nil
+	return d.timezone(RandFromContext(ctx)), nil
 }
 
 // Timezone get timezone randomly in string
 func Timezone() string {
 	datetime := DateTime{}
-	return datetime.timezone()
+	return datetime.timezone(globalRand)
 }
 
-func (d DateTime) period() string {
-	return time.Unix(RandomUnixTime(), 0).Format(TimePeriodFormat)
+func (d DateTime) period(r *rand.Rand, now time.Time) string {
+	return time.Unix(randomUnixTime(r, now), 0).In(now.Location()).Format(TimePeriodFormat)
 }
 
 // TimePeriod formats DateTime using example TimePeriod const
 func (d DateTime) TimePeriod(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return d.period(), nil
+	return d.period(RandFromContext(ctx), ReferenceTimeFromContext(ctx)), nil
 }
 
 // Timeperiod get timeperiod randomly in string (AM/PM)
 func Timeperiod() string {
 	datetime := DateTime{}
-	return datetime.period()
+	return datetime.period(globalRand, time.Now())
 }
 
 // RandomUnixTime is a helper function returning random Unix time
 func RandomUnixTime() int64 {
-	return rand.Int63n(time.Now().Unix())
+	return randomUnixTime(globalRand, time.Now())
+}
+
+// randomUnixTime returns a random unix time between the epoch and now, or now when it is not after the
+// epoch.
+func randomUnixTime(r *rand.Rand, now time.Time) int64 {
+	if now.Unix() <= 0 {
+		return now.Unix()
+	}
+	return r.Int63n(now.Unix())
 }
@@ -0,0 +1,346 @@
+package fakegen
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	argsStart = "("
+	argsEnd   = ")"
+	// afterMaxDuration bounds how much later than its argument a time derived with after is
+	afterMaxDuration = 30 * 24 * time.Hour
+	// afterMaxStep bounds how much bigger than its argument a number derived with after is
+	afterMaxStep = 100
+)
+
+// DeriveFunction computes the value of a derived field from the values of the fields listed in its
+// derive tag, in the same order. v is a settable zero value of the field, or of the type it points to.
+// A field argument reached through a slice or array, like Items.Price, is passed as a []interface{}
+// holding the value of every element, and nil pointers are passed as nil.
+type DeriveFunction func(ctx context.Context, v reflect.Value, args []interface{}) (interface{}, error)
+
+// derivation is a parsed derive tag, e.g. derive=email_from(FirstName,LastName).
+type derivation struct {
+	name string
+	args []string
+}
+
+var defaultDerivers = map[string]DeriveFunction{
+	EmailFromDeriver: deriveEmail,
+	AfterDeriver:     deriveAfter,
+	SumDeriver:       deriveSum,
+}
+
+// AddDeriver registers fn to compute the fields tagged with derive=name(Field,...).
+// Example:
+// 		generator.AddDeriver("full_name", func(ctx context.Context, v reflect.Value, args []interface{}) (interface{}, error) {
+// 			return fmt.Sprintf("%s %s", args[0], args[1]), nil
+// 		})
+func (f *FakeGenerator) AddDeriver(name string, fn DeriveFunction) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if _, ok := f.derivers[name]; ok {
+		return fmt.Errorf("%w: %q", ErrDeriverExists, name)
+	}
+	f.derivers[name] = fn
+	return nil
+}
+
+// parseDerivation parses the value of a derive tag, like email_from(FirstName, LastName).
+func parseDerivation(value string) (*derivation, error) {
+	value = strings.TrimSpace(value)
+	start := strings.Index(value, argsStart)
+	if start <= 0 || !strings.HasSuffix(value, argsEnd) {
+		return nil, fmt.Errorf("%w: %q", ErrWrongFormattedTag, Derive+Equals+value)
+	}
+	d := &derivation{name: strings.TrimSpace(value[:start]), args: make([]string, 0)}
+	args := strings.TrimSpace(value[start+1 : len(value)-1])
+	if args == "" {
+		return d, nil
+	}
+	for _, arg := range strings.Split(args, comma) {
+		arg = strings.TrimSpace(arg)
+		if arg == "" {
+			return nil, fmt.Errorf("%w: %q", ErrWrongFormattedTag, Derive+Equals+value)
+		}
+		d.args = append(d.args, arg)
+	}
+	return d, nil
+}
+
+// orderDerived returns fields, the plan of the struct t generated at path, with the derived fields
+// moved after the others, each after the derived fields it depends on.
+func orderDerived(t reflect.Type, path string, fields []fieldPlan) ([]fieldPlan, error) {
+	ordered := make([]fieldPlan, 0, len(fields))
+	derived := make(map[string]fieldPlan)
+	for _, field := range fields {
+		if field.tags.derive == nil {
+			ordered = append(ordered, field)
+			continue
+		}
+		for _, arg := range field.tags.derive.args {
+			if err := checkFieldPath(t, arg); err != nil {
+				return nil, newFieldError(t, joinPath(path, field.name), field.tags.tag, err)
+			}
+		}
+		derived[field.name] = field
+	}
+
+	// depth first, a field being visited that is met again is part of a cycle
+	const visiting, visited = 1, 2
+	state := make(map[string]int)
+	var visit func(field fieldPlan) error
+	visit = func(field fieldPlan) error {
+		switch state[field.name] {
+		case visiting:
+			err := fmt.Errorf("%w: %s", ErrDerivationCycle, joinPath(path, field.name))
+			return newFieldError(t, joinPath(path, field.name), field.tags.tag, err)
+		case visited:
+			return nil
+		}
+		state[field.name] = visiting
+		for _, arg := range field.tags.derive.args {
+			if dep, ok := derived[strings.Split(arg, pathSeparator)[0]]; ok {
+				if err := visit(dep); err != nil {
+					return err
+				}
+			}
+		}
+		state[field.name] = visited
+		ordered = append(ordered, field)
+		return nil
+	}
+	for _, field := range fields {
+		if field.tags.derive != nil {
+			if err := visit(field); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return ordered, nil
+}
+
+// checkFieldPath checks that the dot separated path names an exported field of the struct t,
+// following pointers, slices and arrays on the way.
+func checkFieldPath(t reflect.Type, path string) error {
+	for _, name := range strings.Split(path, pathSeparator) {
+		for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return fmt.Errorf("%w: %s", ErrUnknownField, path)
+		}
+		field, ok := t.FieldByName(name)
+		if !ok || field.PkgPath != "" {
+			return fmt.Errorf("%w: %s", ErrUnknownField, path)
+		}
+		t = field.Type
+	}
+	return nil
+}
+
+// deriveField sets the i-th field of the struct v to the value computed by the deriver of d.
+func (f *FakeGenerator) deriveField(ctx context.Context, v reflect.Value, i int, d *derivation) error {
+	fn, ok := f.derivers[d.name]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnknownDeriver, d.name)
+	}
+	args := make([]interface{}, 0, len(d.args))
+	for _, arg := range d.args {
+		args = append(args, derivedArg(v, strings.Split(arg, pathSeparator)))
+	}
+
+	field := v.Field(i)
+	t := field.Type()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	res, err := fn(ctx, reflect.New(t).Elem(), args)
+	if err != nil {
+		return err
+	}
+	val, err := f.convertDerived(res, t)
+	if err != nil {
+		return err
+	}
+	if field.Kind() == reflect.Ptr {
+		ptr := reflect.New(t)
+		ptr.Elem().Set(val)
+		val = ptr
+	}
+	field.Set(val)
+	return nil
+}
+
+func (f *FakeGenerator) convertDerived(res interface{}, t reflect.Type) (reflect.Value, error) {
+	if res == nil {
+		return reflect.Zero(t), nil
+	}
+	if isNumber(t) {
+		res = f.castNumber(res, t)
+	}
+	val := reflect.ValueOf(res)
+	if !val.Type().ConvertibleTo(t) || (t.Kind() == reflect.String && val.Kind() != reflect.String) {
+		return reflect.Value{}, fmt.Errorf("%w: %s can not be used as %s", ErrWrongProviderType, val.Type(), t)
+	}
+	return val.Convert(t), nil
+}
+
+// derivedArg returns the value of the field reached through names from v.
+func derivedArg(v reflect.Value, names []string) interface{} {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if len(names) == 0 {
+		return v.Interface()
+	}
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		values := make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			values = append(values, derivedArg(v.Index(i), names))
+		}
+		return values
+	case reflect.Struct:
+		return derivedArg(v.FieldByName(names[0]), names[1:])
+	}
+	return nil
+}
+
+func isNumber(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// deriveEmail returns an email whose local part is made of its arguments, e.g. jane.doe@vxkqe.org
+// for Jane and Doe.
+func deriveEmail(ctx context.Context, v reflect.Value, args []interface{}) (interface{}, error) {
+	r := RandFromContext(ctx)
+	parts := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg == nil {
+			continue
+		}
+		part := strings.Map(func(c rune) rune {
+			if unicode.IsLetter(c) || unicode.IsDigit(c) {
+				return unicode.ToLower(c)
+			}
+			return -1
+		}, fmt.Sprint(arg))
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	if len(parts) == 0 {
+		parts = append(parts, strings.ToLower(randomString(r, 7)))
+	}
+	return strings.Join(parts, ".") + "@" + strings.ToLower(randomString(r, 5)) + "." + randomElementFromSliceString(r, tld), nil
+}
+
+// deriveAfter returns a time or number later than or bigger than its argument, e.g. an EndDate
+// after StartDate.
+func deriveAfter(ctx context.Context, v reflect.Value, args []interface{}) (interface{}, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf("%w: (%d)", ErrMoreArguments, len(args))
+	}
+	if args[0] == nil {
+		return nil, nil
+	}
+	r := RandFromContext(ctx)
+	if start, ok := args[0].(time.Time); ok {
+		return start.Add(time.Duration(1 + r.Int63n(int64(afterMaxDuration)))), nil
+	}
+	arg := reflect.ValueOf(args[0])
+	switch arg.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		step := 1 + r.Int63n(afterMaxStep)
+		if isNumber(v.Type()) && !isUnsigned(v.Type()) && arg.Int() > kindRange(v.Type()).max-step {
+			return kindRange(v.Type()).max, nil
+		}
+		return arg.Int() + step, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		step := uint64(1 + r.Int63n(afterMaxStep))
+		if isUnsigned(v.Type()) && arg.Uint() > kindRange(v.Type()).umax-step {
+			return kindRange(v.Type()).umax, nil
+		}
+		return arg.Uint() + step, nil
+	case reflect.Float32, reflect.Float64:
+		return arg.Float() + (1-r.Float64())*afterMaxStep, nil
+	}
+	return nil, fmt.Errorf("%w: %s with %s", ErrNotSupportedTypeForTag, AfterDeriver, arg.Type())
+}
+
+// deriveSum returns the sum of its arguments, adding up the elements of slices such as the
+// prices of line items. Integers are added up exactly, as an int64, or a uint64 when none of them is
+// negative and the sum does not fit an int64, and the sum is a float64 only when a float is among them.
+func deriveSum(ctx context.Context, v reflect.Value, args []interface{}) (interface{}, error) {
+	var total sum
+	for _, arg := range args {
+		if err := total.add(reflect.ValueOf(arg)); err != nil {
+			return nil, err
+		}
+	}
+	return total.value(), nil
+}
+
+// sum adds up signed and unsigned integers apart, so neither loses precision, and floats.
+type sum struct {
+	signed    int64
+	unsigned  uint64
+	float     float64
+	hasFloat  bool
+	hasSigned bool
+}
+
+func (s *sum) add(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Invalid:
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s.signed += v.Int()
+		s.hasSigned = true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		s.unsigned += v.Uint()
+	case reflect.Float32, reflect.Float64:
+		s.float += v.Float()
+		s.hasFloat = true
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			return s.add(v.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := s.add(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("%w: %s with %s", ErrNotSupportedTypeForTag, SumDeriver, v.Type())
+	}
+	return nil
+}
+
+func (s *sum) value() interface{} {
+	switch {
+	case s.hasFloat:
+		return s.float + float64(s.signed) + float64(s.unsigned)
+	case !s.hasSigned:
+		return s.unsigned
+	case s.signed >= 0 && s.unsigned > math.MaxInt64-uint64(s.signed):
+		return s.unsigned + uint64(s.signed)
+	}
+	return s.signed + int64(s.unsigned)
+}
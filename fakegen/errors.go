@@ -0,0 +1,56 @@
+package fakegen
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// FieldError is returned when a field of a struct can not be generated. It unwraps to the
+// underlying cause, so sentinel errors like ErrTagNotSupported can still be checked with errors.Is.
+type FieldError struct {
+	// Struct is the type of the struct holding the field.
+	Struct reflect.Type
+	// Path is the dotted path of the field from the value passed to FakeData, e.g. Order.Customer.Email.
+	Path string
+	// Tag is the faker tag of the field, followed by the tag added with AddFieldTag, empty if it has none.
+	Tag string
+	// Err is the underlying cause.
+	Err error
+}
+
+func (e *FieldError) Error() string {
+	if e.Tag == "" {
+		return fmt.Sprintf("field %s of %s: %v", e.Path, e.Struct, e.Err)
+	}
+	return fmt.Sprintf("field %s of %s with tag %q: %v", e.Path, e.Struct, e.Tag, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// newFieldError wraps err in a *FieldError for the field of t at path, whose tag is tag. Errors of
+// nested fields already carry the most precise location, so they are returned unchanged.
+func newFieldError(t reflect.Type, path, tag string, err error) error {
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		return err
+	}
+	return &FieldError{Struct: t, Path: path, Tag: tag, Err: err}
+}
+
+// ValidationError is returned by Validate and lists every field whose tag is malformed, unknown or
+// does not suit the type of the field.
+type ValidationError struct {
+	Fields []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Fields))
+	for _, err := range e.Fields {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Sprintf("%d invalid tags: %s", len(e.Fields), strings.Join(msgs, "; "))
+}
@@ -0,0 +1,50 @@
+package fakegen
+
+import (
+	"context"
+	"reflect"
+)
+
+// Fakeable is implemented by types that generate their own fake values, such as money amounts, IDs or
+// geo points, so they are valid wherever they appear without registering a provider. Fake is called on
+// a pointer to the value to fill, which holds the value passed to FakeData, if any, or else the zero
+// value. It may use g, e.g. g.FakeData(ctx, &m.Amount), and calling g.FakeData on the value itself fills
+// it as if it did not implement Fakeable. Values of tagged fields and of types with a type provider
+// are generated by those instead.
+// Example:
+// 		func (m *Money) Fake(ctx context.Context, g *fakegen.FakeGenerator) error {
+// 			m.Currency = "EUR"
+// 			m.Cents = fakegen.RandFromContext(ctx).Int63n(100000)
+// 			return nil
+// 		}
+type Fakeable interface {
+	Fake(ctx context.Context, g *FakeGenerator) error
+}
+
+type fakingKey struct{}
+
+var fakeableType = reflect.TypeOf((*Fakeable)(nil)).Elem()
+
+func isFakeable(t reflect.Type) bool {
+	return reflect.PtrTo(t).Implements(fakeableType)
+}
+
+// getFakeableValue generates a value of type t, starting from a, with its Fake method when t or a
+// pointer to it implements Fakeable, and reports whether it did. The returned ctx is the one to
+// generate t with otherwise.
+func (f *FakeGenerator) getFakeableValue(ctx context.Context, t reflect.Type, a interface{}) (context.Context, reflect.Value, bool, error) {
+	if faking, _ := ctx.Value(fakingKey{}).(reflect.Type); faking != nil && t.Kind() != reflect.Ptr {
+		// the mark only applies to the value Fake passes to the generator, reached through pointers
+		ctx = context.WithValue(ctx, fakingKey{}, nil)
+		if faking == t {
+			return ctx, reflect.Value{}, false, nil
+		}
+	}
+	if !isFakeable(t) {
+		return ctx, reflect.Value{}, false, nil
+	}
+	v := reflect.New(t)
+	v.Elem().Set(reflect.ValueOf(a))
+	err := v.Interface().(Fakeable).Fake(context.WithValue(ctx, fakingKey{}, t), f)
+	return ctx, v.Elem(), true, err
+}
@@ -6,9 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"reflect"
 	"regexp"
+	"runtime"
 	"strconv"
 	"strings"
 	"sync"
@@ -24,6 +26,18 @@ type numberBoundary struct {
 	end   int
 }
 
+// floatBoundary bounds random floats, [start, end)
+type floatBoundary struct {
+	start float64
+	end   float64
+}
+
+// sizeRange bounds the size of a collection or the length of a string, both ends included.
+type sizeRange struct {
+	min int
+	max int
+}
+
 // Supported tags
 const (
 	letterIdxBits         = 6                    // 6 bits to represent a letter index
@@ -32,6 +46,8 @@ const (
 	letterBytes           = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 	tagName               = "faker"
 	keep                  = "keep"
+	unique                = "unique"
+	Nullable              = "nullable"
 	ID                    = "uuid_digit"
 	HyphenatedID          = "uuid_hyphenated"
 	EmailTag              = "email"
@@ -75,10 +91,20 @@ const (
 	AmountWithCurrencyTag = "amount_with_currency"
 	SKIP                  = "-"
 	Length                = "len"
+	Size                  = "size"
+	Regex                 = "regex"
+	OneOf                 = "oneof"
 	BoundaryStart         = "boundary_start"
 	BoundaryEnd           = "boundary_end"
+	Boundary              = "boundary"
+	Precision             = "precision"
+	Derive                = "derive"
+	EmailFromDeriver      = "email_from"
+	AfterDeriver          = "after"
+	SumDeriver            = "sum"
 	Equals                = "="
 	comma                 = ","
+	mapKeyAttempts        = 10 // keys generated per map entry before settling for a smaller map
 )
 
 var defaultTag = map[string]string{
@@ -173,7 +199,8 @@ var mapperTag = map[string]TaggedFunction{
 	HyphenatedID:          GetIdentifier().Hyphenated,
 }
 
-// Generic Error Messages for tags
+// Errors returned by the generator, possibly wrapped with details or in a *FieldError, so they should be
+// checked with errors.Is
 // 		ErrUnsupportedKindPtr: Error when get fake from ptr
 // 		ErrUnsupportedKind: Error on passing unsupported kind
 // 		ErrValueNotPtr: Error when value is not pointer
@@ -181,63 +208,227 @@ var mapperTag = map[string]TaggedFunction{
 // 		ErrTagAlreadyExists: Error when tag exists and call AddProvider
 // 		ErrMoreArguments: Error on passing more arguments
 // 		ErrNotSupportedPointer: Error when passing unsupported pointer
+// 		ErrNotSlicePtr: Error when FakeMany is not passed a pointer to a slice
+// 		ErrSmallerThanZero: Error when a size, depth or count is negative
+// 		ErrStartValueBiggerThanEnd: Error when a range starts after it ends
+// 		ErrWrongFormattedTag: Error when a tag does not follow the tag grammar, or a regex tag is not a valid pattern
+// 		ErrUnknownType: Error when a value of the type can not be generated for a tag
+// 		ErrNotSupportedTypeForTag: Error when a tag option does not suit the type of the field
+// 		ErrNotInterface: Error when registering an implementation for a type that is not an interface
+// 		ErrNotImplemented: Error when registering an implementation that does not implement the interface
+// 		ErrNoImplementation: Error when no implementation is registered for an interface
+// 		ErrWeightNotPositive: Error when a weight is not bigger than zero
+// 		ErrTypeProviderExists: Error when a provider is already registered for the type
+// 		ErrWrongProviderType: Error when a provider returns a value that can not be used for the field
+// 		ErrRegexNoMatch: Error when a regex tag can not match any string
+// 		ErrOneOfValue: Error when a value of a oneof tag can not be converted to the field type
+// 		ErrUniqueExhausted: Error when no unique value is found within the retries
+// 		ErrUniqueNotComparable: Error when unique is used on a type whose values can not be compared
+// 		ErrProbabilityRange: Error when a probability is not between 0 and 1
+// 		ErrNotNullable: Error when a nil probability is set for a type that can not be nil
+// 		ErrBoundaryOutOfRange: Error when a tag boundary does not fit the type of the field
+// 		ErrDeriverExists: Error when a deriver is already registered with the name
+// 		ErrUnknownDeriver: Error when a derive tag names no registered deriver
+// 		ErrUnknownField: Error when a derive tag names a field that does not exist
+// 		ErrDerivationCycle: Error when derived fields depend on each other
+// 		ErrSkipField: Returned by a BeforeFieldHook to leave a field as it is
 var (
-	ErrUnsupportedKindPtr  = "Unsupported kind: %s Change Without using * (pointer) in Field of %s"
-	ErrUnsupportedKind     = "Unsupported kind: %s"
-	ErrValueNotPtr         = "Not a pointer value"
-	ErrTagNotSupported     = "Tag unsupported"
-	ErrTagAlreadyExists    = "Tag exists"
-	ErrMoreArguments       = "Passed more arguments than is possible : (%d)"
-	ErrNotSupportedPointer = "Use sample:=new(%s)\n faker.FakeData(sample) instead"
-	ErrSmallerThanZero     = "Size:%d is smaller than zero."
-
-	ErrStartValueBiggerThanEnd = "Start value can not be bigger than end value."
-	ErrWrongFormattedTag       = "Tag \"%s\" is not written properly"
-	ErrUnknownType             = "Unknown Type"
-	ErrNotSupportedTypeForTag  = "Type is not supported by tag."
+	ErrUnsupportedKindPtr  = errors.New("Unsupported kind, change without using * (pointer)")
+	ErrUnsupportedKind     = errors.New("Unsupported kind")
+	ErrValueNotPtr         = errors.New("Not a pointer value")
+	ErrNotSlicePtr         = errors.New("Not a pointer to a slice")
+	ErrTagNotSupported     = errors.New("Tag unsupported")
+	ErrTagAlreadyExists    = errors.New("Tag exists")
+	ErrMoreArguments       = errors.New("Passed more arguments than is possible")
+	ErrNotSupportedPointer = errors.New("Nil pointer not supported")
+	ErrSmallerThanZero     = errors.New("Size is smaller than zero")
+
+	ErrStartValueBiggerThanEnd = errors.New("Start value can not be bigger than end value.")
+	ErrWrongFormattedTag       = errors.New("Tag is not written properly")
+	ErrUnknownType             = errors.New("Unknown Type")
+	ErrNotSupportedTypeForTag  = errors.New("Type is not supported by tag.")
+	ErrNotInterface            = errors.New("Not an interface type")
+	ErrNotImplemented          = errors.New("Type does not implement the interface")
+	ErrNoImplementation        = errors.New("No implementation registered for interface")
+	ErrWeightNotPositive       = errors.New("Weight must be bigger than zero")
+	ErrTypeProviderExists      = errors.New("Provider for type exists")
+	ErrWrongProviderType       = errors.New("Provider returned a value of the wrong type")
+	ErrRegexNoMatch            = errors.New("Pattern can not match any string")
+	ErrOneOfValue              = errors.New("Value of oneof tag can not be used")
+	ErrUniqueExhausted         = errors.New("Could not generate a unique value")
+	ErrUniqueNotComparable     = errors.New("Unique values can not be tracked")
+	ErrProbabilityRange        = errors.New("Probability must be between 0 and 1")
+	ErrNotNullable             = errors.New("Type can not be nil")
+	ErrBoundaryOutOfRange      = errors.New("Boundary is out of range")
+	ErrDeriverExists           = errors.New("Deriver exists")
+	ErrUnknownDeriver          = errors.New("Deriver unsupported")
+	ErrUnknownField            = errors.New("Field does not exist")
+	ErrDerivationCycle         = errors.New("Derived fields depend on each other")
+	ErrSkipField               = errors.New("Skip field")
 )
 
-func NewFakeGenerator() *FakeGenerator {
+// NewFakeGenerator returns a generator with the default configuration. Options can be
+// passed to control how random values are drawn, e.g. WithSeed for reproducible output.
+func NewFakeGenerator(opts ...Option) *FakeGenerator {
 	fg := FakeGenerator{fieldTags: make(map[string]string),
-		tagProviders:    make(map[string]TaggedFunction),
-		fieldFilter:     make([]*regexp.Regexp, 0),
-		shouldSetNil:    false,
-		randomStringLen: 25,
-		randomSize:      100,
-		nBoundary:       numberBoundary{start: 0, end: 100},
-		testRandZero:    false}
+		tagProviders:       make(map[string]TaggedFunction),
+		fieldFilter:        make([]*regexp.Regexp, 0),
+		shouldSetNil:       false,
+		randomStringLen:    25,
+		randomSize:         100,
+		nBoundary:          numberBoundary{start: 0, end: 100},
+		testRandZero:       false,
+		maxDepth:           1,
+		interfaceImpls:     make(map[reflect.Type][]implementation),
+		typeProviders:      make(map[reflect.Type]TaggedFunction),
+		uniqueValues:       newUniqueValues(),
+		uniqueTags:         make(map[string]bool),
+		uniqueRetries:      100,
+		plans:              newPlanCache(),
+		typeNilProbability: make(map[reflect.Type]float64),
+		derivers:           make(map[string]DeriveFunction)}
 
 	for k, v := range mapperTag {
 		fg.tagProviders[k] = v
 	}
+	for name, fn := range defaultDerivers {
+		fg.derivers[name] = fn
+	}
+	for _, opt := range opts {
+		opt(&fg)
+	}
 	fg.init()
 	return &fg
 }
 
+// FakeGenerator generates fake data according to its configuration. Once configured it is safe for
+// concurrent use by multiple goroutines; configuration methods may also be called concurrently and
+// wait for the generations in progress to finish. Values are only reproducible for a given seed when
+// generated sequentially or with FakeManyParallel, and with FakeManyParallel only without unique fields.
 type FakeGenerator struct {
-	fieldTags       map[string]string
-	tagProviders    map[string]TaggedFunction
-	fieldFilter     []*regexp.Regexp
-	shouldSetNil    bool
-	randomStringLen int
-	randomSize      int
-	nBoundary       numberBoundary
-	testRandZero    bool
+	fieldTags          map[string]string
+	tagProviders       map[string]TaggedFunction
+	fieldFilter        []*regexp.Regexp
+	fieldPathFilter    []*regexp.Regexp
+	shouldSetNil       bool
+	randomStringLen    int
+	randomSize         int
+	minRandomSize      int
+	nBoundary          numberBoundary
+	fBoundary          *floatBoundary
+	fullNumberRange    bool
+	edgeProbability    float64
+	testRandZero       bool
+	rand               *rand.Rand
+	seed               int64
+	referenceTime      time.Time
+	maxDepth           int
+	interfaceImpls     map[reflect.Type][]implementation
+	typeProviders      map[reflect.Type]TaggedFunction
+	uniqueValues       *uniqueValues
+	uniqueTags         map[string]bool
+	uniqueRetries      int
+	plans              *planCache
+	nilProbability     float64
+	typeNilProbability map[reflect.Type]float64
+	derivers           map[string]DeriveFunction
+	beforeFieldHooks   []BeforeFieldHook
+	afterValueHooks    []AfterValueHook
+	fillZeroOnly       bool
+	lock               sync.RWMutex
+}
+
+// Option configures a FakeGenerator when it is created by NewFakeGenerator.
+type Option func(*FakeGenerator)
+
+// WithSeed makes every built-in provider and helper used by the generator draw from a
+// source seeded with seed, so the same seed and the same input produce the same output.
+func WithSeed(seed int64) Option {
+	return func(f *FakeGenerator) {
+		f.seed = seed
+		f.rand = rand.New(newLockedSource(rand.NewSource(seed)))
+	}
 }
 
+// WithRandSource makes the generator draw every random value from src.
+// The source is not seeded by the generator, so Seed reports 0.
+func WithRandSource(src rand.Source) Option {
+	return func(f *FakeGenerator) {
+		f.seed = 0
+		f.rand = rand.New(newLockedSource(src))
+	}
+}
+
+// WithReferenceTime sets the instant time based values (time.Time fields, unix_time, date, ...)
+// are generated relative to. Values are generated in UTC, so they do not depend on the local time
+// zone. It defaults to January 1st 2020 when the generator is given a seed or a random source, so
+// the seed alone replays a run byte for byte, and to the moment the generator was created otherwise.
+func WithReferenceTime(t time.Time) Option {
+	return func(f *FakeGenerator) {
+		f.referenceTime = t.UTC()
+	}
+}
+
+// seededReferenceTime is the reference time of generators created with WithSeed or WithRandSource
+// and without WithReferenceTime.
+var seededReferenceTime = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
 func (f *FakeGenerator) init() {
-	rand.Seed(time.Now().UnixNano())
+	if f.referenceTime.IsZero() {
+		f.referenceTime = time.Now().UTC()
+		if f.rand != nil {
+			f.referenceTime = seededReferenceTime
+		}
+	}
+	if f.rand == nil {
+		f.seed = time.Now().UnixNano()
+		f.rand = rand.New(newLockedSource(rand.NewSource(f.seed)))
+	}
+}
+
+// Seed returns the seed of the generator's random source. Log it when a test fails and pass it
+// to WithSeed to replay the same values locally.
+func (f *FakeGenerator) Seed() int64 {
+	return f.seed
+}
+
+// ReferenceTime returns the instant time based values are generated relative to.
+func (f *FakeGenerator) ReferenceTime() time.Time {
+	return f.referenceTime
+}
+
+// withRandomSource attaches the generator's random source and reference time to ctx so
+// built-in and custom providers draw from the same stream.
+func (f *FakeGenerator) withRandomSource(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, randKey{}, f.rand)
+	return context.WithValue(ctx, referenceTimeKey{}, f.referenceTime)
+}
+
+// begin prepares ctx for generating with f and locks its configuration until the returned
+// function is called. Calls made from providers while f is generating keep the lock and the
+// random source already attached to ctx.
+func (f *FakeGenerator) begin(ctx context.Context) (context.Context, func()) {
+	if ctx.Value(generatorKey{}) == f {
+		return ctx, func() {}
+	}
+	f.lock.RLock()
+	ctx = context.WithValue(f.withRandomSource(ctx), generatorKey{}, f)
+	return ctx, f.lock.RUnlock
 }
 
 // SetNilIfLenIsZero allows to set nil for the slice and maps, if size is 0.
 func (f *FakeGenerator) SetNilIfLenIsZero(setNil bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
 	f.shouldSetNil = setNil
 }
 
 // SetRandomStringLength sets a length for random string generation
 func (f *FakeGenerator) SetRandomStringLength(size int) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
 	if size < 0 {
-		return fmt.Errorf(ErrSmallerThanZero, size)
+		return fmt.Errorf("%w: %d", ErrSmallerThanZero, size)
 	}
 	f.randomStringLen = size
 	return nil
@@ -245,33 +436,120 @@ func (f *FakeGenerator) SetRandomStringLength(size int) error {
 
 // SetRandomMapAndSliceSize sets the size for maps and slices for random generation.
 func (f *FakeGenerator) SetRandomMapAndSliceSize(size int) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
 	if size < 0 {
-		return fmt.Errorf(ErrSmallerThanZero, size)
+		return fmt.Errorf("%w: %d", ErrSmallerThanZero, size)
 	}
 	f.randomSize = size
 	return nil
 }
 
-// SetRandomNumberBoundaries sets boundary for random number generation
+// SetMinRandomMapAndSliceSize sets the minimum size of randomly sized maps and slices, e.g. 1 to never
+// generate empty collections. It must be smaller than the size set by SetRandomMapAndSliceSize.
+func (f *FakeGenerator) SetMinRandomMapAndSliceSize(size int) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if size < 0 {
+		return fmt.Errorf("%w: %d", ErrSmallerThanZero, size)
+	}
+	if size >= f.randomSize {
+		return ErrStartValueBiggerThanEnd
+	}
+	f.minRandomSize = size
+	return nil
+}
+
+// SetRandomNumberBoundaries sets boundary for random number generation. Boundaries are clamped to the
+// range of each integer kind, e.g. [0, 255] for uint8 fields.
 func (f *FakeGenerator) SetRandomNumberBoundaries(start, end int) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
 	if start > end {
-		return errors.New(ErrStartValueBiggerThanEnd)
+		return ErrStartValueBiggerThanEnd
 	}
 	f.nBoundary = numberBoundary{start: start, end: end}
 	return nil
 }
 
+// SetRandomFloatBoundaries sets boundary for random float generation, which is [0, 1) by default.
+func (f *FakeGenerator) SetRandomFloatBoundaries(start, end float64) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if start > end {
+		return ErrStartValueBiggerThanEnd
+	}
+	f.fBoundary = &floatBoundary{start: start, end: end}
+	return nil
+}
+
+// floatBoundary returns the boundary of untagged floats.
+func (f *FakeGenerator) floatBoundary() floatBoundary {
+	if f.fBoundary != nil {
+		return *f.fBoundary
+	}
+	return floatBoundary{start: 0, end: 1}
+}
+
+// SetFullNumberRange makes untagged integers range over every value of their kind, e.g. up to
+// math.MaxUint64 for uint64 fields, instead of the boundaries set by SetRandomNumberBoundaries.
+func (f *FakeGenerator) SetFullNumberRange(fullRange bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.fullNumberRange = fullRange
+}
+
+// SetEdgeValueProbability sets the probability of numbers to be one of the edge values 0, -1 and the
+// minimum and maximum allowed for the field, instead of a random value, to shake out overflow bugs.
+// Untagged integers get the minimum and maximum of their kind, even outside the generator's boundaries.
+func (f *FakeGenerator) SetEdgeValueProbability(probability float64) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if err := checkProbability(probability); err != nil {
+		return err
+	}
+	f.edgeProbability = probability
+	return nil
+}
+
+// isEdgeValue decides whether the number being generated is an edge value.
+func (f *FakeGenerator) isEdgeValue(ctx context.Context) bool {
+	return f.edgeProbability > 0 && RandFromContext(ctx).Float64() < f.edgeProbability
+}
+
+// SetMaxRecursionDepth sets how many times a struct type may be nested within itself, e.g. through
+// `Children []*Node`. Once the depth is reached pointers to the type are left nil and slices and maps
+// of it are left empty. Defaults to 1.
+func (f *FakeGenerator) SetMaxRecursionDepth(depth int) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if depth < 0 {
+		return fmt.Errorf("%w: %d", ErrSmallerThanZero, depth)
+	}
+	f.maxDepth = depth
+	return nil
+}
+
 func (f *FakeGenerator) SetTestRandZero(trz bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
 	f.testRandZero = trz
 }
 
 func (f *FakeGenerator) AddFieldFilter(regexStr string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
 	reg := regexp.MustCompile(regexStr)
 	f.fieldFilter = append(f.fieldFilter, reg)
+	f.fieldPathFilter = append(f.fieldPathFilter, regexp.MustCompile("^(?:"+regexStr+")$"))
+	f.plans.clear()
 }
 
 func (f *FakeGenerator) AddFieldTag(field, tag string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
 	f.fieldTags[field] = tag
+	f.plans.clear()
 }
 
 // FakeData is the main function. Will generate a fake data based on your struct.  You can use this for automation testing, or anything that need automated data.
@@ -281,21 +559,141 @@ func (f *FakeGenerator) FakeData(ctx context.Context, a interface{}) error {
 	reflectType := reflect.TypeOf(a)
 
 	if reflectType.Kind() != reflect.Ptr {
-		return errors.New(ErrValueNotPtr)
+		return ErrValueNotPtr
 	}
 
 	if reflect.ValueOf(a).IsNil() {
-		return fmt.Errorf(ErrNotSupportedPointer, reflectType.Elem().String())
+		return fmt.Errorf("%w: use sample:=new(%s)\n faker.FakeData(sample) instead", ErrNotSupportedPointer, reflectType.Elem().String())
 	}
 
 	rval := reflect.ValueOf(a)
 
+	ctx, end := f.begin(ctx)
+	defer end()
+	if f.fillZeroOnly {
+		ctx = withVisited(ctx, rval)
+	}
 	finalValue, err := f.getValue(ctx, a)
 	if err != nil {
 		return err
 	}
 
 	rval.Elem().Set(finalValue.Elem().Convert(reflectType.Elem()))
+	return f.afterValue(ctx, rval.Elem())
+}
+
+// IndexOverride adjusts the i-th element generated by FakeMany. elem is a pointer to the element.
+type IndexOverride func(i int, elem interface{}) error
+
+// FakeMany fills the slice a points to with n generated elements, replacing its previous content.
+// Overrides are called in order for every element once it is generated, e.g. to give the first
+// element of a fixture a well-known ID.
+// Example:
+// 		var users []User
+// 		err := generator.FakeMany(ctx, &users, 100, func(i int, elem interface{}) error {
+// 			elem.(*User).ID = int64(i + 1)
+// 			return nil
+// 		})
+func (f *FakeGenerator) FakeMany(ctx context.Context, a interface{}, n int, overrides ...IndexOverride) error {
+	if err := checkSlicePtr(a, n); err != nil {
+		return err
+	}
+
+	ctx, end := f.begin(ctx)
+	defer end()
+	list := reflect.MakeSlice(reflect.TypeOf(a).Elem(), n, n)
+	for i := 0; i < n; i++ {
+		if err := f.fakeElement(ctx, list, i, overrides); err != nil {
+			return err
+		}
+	}
+	reflect.ValueOf(a).Elem().Set(list)
+	return nil
+}
+
+// FakeManyParallel fills the slice a points to with n generated elements like FakeMany, spreading
+// the work over workers goroutines (GOMAXPROCS when workers is not positive). Every element draws
+// from its own source seeded from the generator's, so the result does not depend on scheduling and
+// is the same for a given seed, unless the elements have unique fields: elements generated at the same
+// time compete for the unique values, so which one has to regenerate its value depends on scheduling.
+// Overrides may be called concurrently for different elements.
+func (f *FakeGenerator) FakeManyParallel(ctx context.Context, a interface{}, n, workers int, overrides ...IndexOverride) error {
+	if err := checkSlicePtr(a, n); err != nil {
+		return err
+	}
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	ctx, end := f.begin(ctx)
+	defer end()
+	r := RandFromContext(ctx)
+	seeds := make([]int64, n)
+	for i := range seeds {
+		seeds[i] = r.Int63()
+	}
+
+	list := reflect.MakeSlice(reflect.TypeOf(a).Elem(), n, n)
+	errs := make([]error, n)
+	indexes := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				ctx := context.WithValue(ctx, randKey{}, rand.New(rand.NewSource(seeds[i])))
+				errs[i] = f.fakeElement(ctx, list, i, overrides)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	reflect.ValueOf(a).Elem().Set(list)
+	return nil
+}
+
+// checkSlicePtr validates the arguments of FakeMany and FakeManyParallel.
+func checkSlicePtr(a interface{}, n int) error {
+	reflectType := reflect.TypeOf(a)
+	if reflectType == nil || reflectType.Kind() != reflect.Ptr || reflectType.Elem().Kind() != reflect.Slice {
+		return ErrNotSlicePtr
+	}
+	if reflect.ValueOf(a).IsNil() {
+		return fmt.Errorf("%w: use sample:=new(%s)\n faker.FakeData(sample) instead", ErrNotSupportedPointer, reflectType.Elem().String())
+	}
+	if n < 0 {
+		return fmt.Errorf("%w: %d", ErrSmallerThanZero, n)
+	}
+	return nil
+}
+
+// fakeElement generates the i-th element of list and applies the overrides to it.
+func (f *FakeGenerator) fakeElement(ctx context.Context, list reflect.Value, i int, overrides []IndexOverride) error {
+	elemType := list.Type().Elem()
+	val, err := f.newValue(ctx, elemType)
+	if err != nil {
+		return err
+	}
+	elem := list.Index(i)
+	elem.Set(val.Convert(elemType))
+	if err := f.afterValue(ctx, elem); err != nil {
+		return err
+	}
+	for _, override := range overrides {
+		if err := override(i, elem.Addr().Interface()); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -341,36 +739,104 @@ func (f *FakeGenerator) FakeData(ctx context.Context, a interface{}) error {
 //
 // Will print
 // 		{ID:43 Gondoruwo:{Name:Power Locatadata:324} Danger:danger-ranger}
-// Notes: when using a custom provider make sure to return the same type as the field
+// Notes: when using a custom provider make sure to return the same type as the field.
+// Providers should draw random values from RandFromContext(ctx) so they follow the generator's seed;
+// FieldPathFromContext(ctx) tells which field is being generated.
 func (f *FakeGenerator) AddProvider(tag string, provider TaggedFunction) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
 	if _, ok := f.tagProviders[tag]; ok {
-		return errors.New(ErrTagAlreadyExists)
+		return ErrTagAlreadyExists
 	}
 
 	f.tagProviders[tag] = provider
+	f.plans.clear()
 
 	return nil
 }
 
+// newValue generates a value of type t. Unlike getValue it also accepts interface types, whose zero
+// value carries no type information, and resolves them through the registered implementations.
+func (f *FakeGenerator) newValue(ctx context.Context, t reflect.Type) (reflect.Value, error) {
+	if f.isRandomNil(ctx, t) {
+		return reflect.Zero(t), nil
+	}
+	if provider, ok := f.typeProviders[t]; ok {
+		return f.getValueFromTypeProvider(ctx, t, provider)
+	}
+	if t.Kind() == reflect.Interface {
+		return f.getInterfaceValue(ctx, t)
+	}
+	return f.getValue(ctx, reflect.Zero(t).Interface())
+}
+
+// AddTypeProvider registers provider to generate every value of type t that has no tag, wherever it
+// appears: struct fields, slice and array elements, map keys and values, or behind pointers.
+// The provider receives a settable zero value of t and should return a value convertible to t.
+// Example:
+// 		generator.AddTypeProvider(reflect.TypeOf(decimal.Decimal{}), func(ctx context.Context, v reflect.Value) (interface{}, error) {
+// 			return decimal.New(fakegen.RandFromContext(ctx).Int63n(10000), -2), nil
+// 		})
+func (f *FakeGenerator) AddTypeProvider(t reflect.Type, provider TaggedFunction) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if _, ok := f.typeProviders[t]; ok {
+		return fmt.Errorf("%w: %s", ErrTypeProviderExists, t)
+	}
+
+	f.typeProviders[t] = provider
+
+	return nil
+}
+
+// getValueFromTypeProvider generates a value of type t with the provider registered for it.
+func (f *FakeGenerator) getValueFromTypeProvider(ctx context.Context, t reflect.Type, provider TaggedFunction) (reflect.Value, error) {
+	res, err := provider(ctx, reflect.New(t).Elem())
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	if res == nil {
+		return reflect.Zero(t), nil
+	}
+	val := reflect.ValueOf(res)
+	if !val.Type().ConvertibleTo(t) {
+		return reflect.Value{}, fmt.Errorf("%w: %s can not be used as %s", ErrWrongProviderType, val.Type(), t)
+	}
+	return val.Convert(t), nil
+}
+
 func (f *FakeGenerator) getValue(ctx context.Context, a interface{}) (reflect.Value, error) {
 	t := reflect.TypeOf(a)
 	if t == nil {
-		return reflect.Value{}, fmt.Errorf("interface{} not allowed")
+		return reflect.Value{}, fmt.Errorf("%w: interface{} not allowed", ErrUnknownType)
+	}
+	if provider, ok := f.typeProviders[t]; ok {
+		return f.getValueFromTypeProvider(ctx, t, provider)
+	}
+	ctx, val, ok, err := f.getFakeableValue(ctx, t, a)
+	if ok {
+		return val, err
+	}
+	if val, ok, err := f.getStandardValue(ctx, t); ok {
+		return val, err
 	}
 	k := t.Kind()
 
 	switch k {
 	case reflect.Ptr:
+		if f.isRecursionLimitReached(ctx, t) {
+			return reflect.Zero(t), nil
+		}
 		v := reflect.New(t.Elem())
 		var val reflect.Value
 		var err error
-		if a != reflect.Zero(reflect.TypeOf(a)).Interface() {
+		if a != reflect.Zero(reflect.TypeOf(a)).Interface() && !isNilInterface(reflect.ValueOf(a).Elem()) {
 			val, err = f.getValue(ctx, reflect.ValueOf(a).Elem().Interface())
 			if err != nil {
 				return reflect.Value{}, err
 			}
 		} else {
-			val, err = f.getValue(ctx, v.Elem().Interface())
+			val, err = f.newValue(ctx, t.Elem())
 			if err != nil {
 				return reflect.Value{}, err
 			}
@@ -378,120 +844,111 @@ func (f *FakeGenerator) getValue(ctx context.Context, a interface{}) (reflect.Va
 		v.Elem().Set(val.Convert(t.Elem()))
 		return v, nil
 	case reflect.Struct:
+		v := reflect.New(t).Elem()
+		ctx := withRootType(withStructType(ctx, t), t)
 
-		switch t.String() {
-		case "time.Time":
-			ft := time.Now().Add(time.Duration(rand.Int63()))
-			return reflect.ValueOf(ft), nil
-		default:
-			v := reflect.New(t).Elem()
-			typeOfV := v.Type()
+		plan, err := f.structPlan(ctx, t)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		for _, field := range plan.fields {
+			ctx := withEmbedding(withFieldPath(ctx, field.name), field.embedding)
+			if field.tags.nullable || field.tags.size != nil {
+				ctx = withFieldTags(ctx, t.Field(field.index).Type, field.tags)
+			}
 
-			for i := 0; i < v.NumField(); i++ {
-				if !v.Field(i).CanSet() || f.isExcluded(typeOfV.Field(i).Name) {
-					continue // to avoid panic to set on unexported field in struct
-				}
-				tags := f.decodeTags(t, i)
-
-				switch {
-				case tags.keepOriginal:
-					zero, err := f.isZero(reflect.ValueOf(a).Field(i))
-					if err != nil {
-						return reflect.Value{}, err
-					}
-					if zero {
-						err := f.setDataWithTag(ctx, v.Field(i).Addr(), tags.fieldType, nil)
-						if err != nil {
-							return reflect.Value{}, err
-						}
-						continue
-					}
-					v.Field(i).Set(reflect.ValueOf(a).Field(i))
-				case tags.fieldType == "":
-					val, err := f.getValue(ctx, v.Field(i).Interface())
-					if err != nil {
-						return reflect.Value{}, err
-					}
-					val = val.Convert(v.Field(i).Type())
-					v.Field(i).Set(val)
-				case tags.fieldType == SKIP:
-					continue
-				default:
-					err := f.setDataWithTag(ctx, v.Field(i).Addr(), tags.fieldType, v.Field(i).Type())
-					if err != nil {
-						return reflect.Value{}, err
-					}
+			generate, err := f.beforeField(ctx, v, t.Field(field.index))
+			if generate && err == nil {
+				if field.unique {
+					err = f.setUniqueField(ctx, a, v, field.index, field.tags)
+				} else {
+					err = f.setField(ctx, a, v, field.index, field.tags)
 				}
-
 			}
-			return v, nil
+			if err == nil {
+				err = f.afterValue(ctx, v.Field(field.index))
+			}
+			if err != nil {
+				return reflect.Value{}, newFieldError(t, FieldPathFromContext(ctx), field.tags.tag, err)
+			}
 		}
-
+		if err := afterFake(ctx, v); err != nil {
+			return reflect.Value{}, err
+		}
+		return v, nil
 	case reflect.String:
-		res := RandomString(f.randomStringLen)
+		res := randomString(RandFromContext(ctx), f.randomStringLen)
 		return reflect.ValueOf(res), nil
-	case reflect.Array, reflect.Slice:
-		len := f.randomSliceAndMapSize()
+	case reflect.Array:
+		v := reflect.New(t).Elem()
+		for i := 0; i < v.Len(); i++ {
+			val, err := f.newValue(ctx, t.Elem())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			v.Index(i).Set(val.Convert(t.Elem()))
+		}
+		return v, nil
+	case reflect.Slice:
+		if f.isRecursionLimitReached(ctx, t) {
+			return reflect.MakeSlice(t, 0, 0), nil
+		}
+		len := f.randomSliceAndMapSize(ctx, t)
 		if f.shouldSetNil && len == 0 {
 			return reflect.Zero(t), nil
 		}
 		v := reflect.MakeSlice(t, len, len)
 		for i := 0; i < v.Len(); i++ {
-			val, err := f.getValue(ctx, v.Index(i).Interface())
+			val, err := f.newValue(ctx, t.Elem())
 			if err != nil {
 				return reflect.Value{}, err
 			}
 			v.Index(i).Set(val)
 		}
 		return v, nil
-	case reflect.Int:
-		return reflect.ValueOf(RandomIntegerWithBoundary(f.nBoundary)), nil
-	case reflect.Int8:
-		return reflect.ValueOf(int8(RandomIntegerWithBoundary(f.nBoundary))), nil
-	case reflect.Int16:
-		return reflect.ValueOf(int16(RandomIntegerWithBoundary(f.nBoundary))), nil
-	case reflect.Int32:
-		return reflect.ValueOf(int32(RandomIntegerWithBoundary(f.nBoundary))), nil
-	case reflect.Int64:
-		return reflect.ValueOf(int64(RandomIntegerWithBoundary(f.nBoundary))), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return f.randomInteger(ctx, t, f.generatorRange(t), kindRange(t)), nil
 	case reflect.Float32:
-		return reflect.ValueOf(rand.Float32()), nil
+		if f.isEdgeValue(ctx) {
+			return reflect.ValueOf(float32(edgeFloat(RandFromContext(ctx), f.floatBoundary()))), nil
+		}
+		if f.fBoundary != nil {
+			return reflect.ValueOf(float32(randomFloatWithBoundary(RandFromContext(ctx), *f.fBoundary))), nil
+		}
+		return reflect.ValueOf(RandFromContext(ctx).Float32()), nil
 	case reflect.Float64:
-		return reflect.ValueOf(rand.Float64()), nil
+		if f.isEdgeValue(ctx) {
+			return reflect.ValueOf(edgeFloat(RandFromContext(ctx), f.floatBoundary())), nil
+		}
+		if f.fBoundary != nil {
+			return reflect.ValueOf(randomFloatWithBoundary(RandFromContext(ctx), *f.fBoundary)), nil
+		}
+		return reflect.ValueOf(RandFromContext(ctx).Float64()), nil
 	case reflect.Bool:
-		val := rand.Intn(2) > 0
+		val := RandFromContext(ctx).Intn(2) > 0
 		return reflect.ValueOf(val), nil
 
-	case reflect.Uint:
-		return reflect.ValueOf(uint(RandomIntegerWithBoundary(f.nBoundary))), nil
-
-	case reflect.Uint8:
-		return reflect.ValueOf(uint8(RandomIntegerWithBoundary(f.nBoundary))), nil
-
-	case reflect.Uint16:
-		return reflect.ValueOf(uint16(RandomIntegerWithBoundary(f.nBoundary))), nil
-
-	case reflect.Uint32:
-		return reflect.ValueOf(uint32(RandomIntegerWithBoundary(f.nBoundary))), nil
-
-	case reflect.Uint64:
-		return reflect.ValueOf(uint64(RandomIntegerWithBoundary(f.nBoundary))), nil
-
 	case reflect.Map:
-		len := f.randomSliceAndMapSize()
+		if f.isRecursionLimitReached(ctx, t) {
+			return reflect.MakeMap(t), nil
+		}
+		len := f.randomSliceAndMapSize(ctx, t)
 		if f.shouldSetNil && len == 0 {
 			return reflect.Zero(t), nil
 		}
 		v := reflect.MakeMap(t)
-		for i := 0; i < len; i++ {
-			keyInstance := reflect.New(t.Key()).Elem().Interface()
-			key, err := f.getValue(ctx, keyInstance)
+		for i := 0; v.Len() < len && i < len*mapKeyAttempts; i++ {
+			key, err := f.newValue(withMapKey(ctx), t.Key())
 			if err != nil {
 				return reflect.Value{}, err
 			}
+			if !key.Type().Comparable() {
+				// an implementation registered for an interface key may not be hashable
+				continue
+			}
 
-			valueInstance := reflect.New(t.Elem()).Elem().Interface()
-			val, err := f.getValue(ctx, valueInstance)
+			val, err := f.newValue(ctx, t.Elem())
 			if err != nil {
 				return reflect.Value{}, err
 			}
@@ -499,86 +956,157 @@ func (f *FakeGenerator) getValue(ctx context.Context, a interface{}) (reflect.Va
 		}
 		return v, nil
 	default:
-		err := fmt.Errorf("no support for kind %+v", t)
+		err := fmt.Errorf("%w: %s", ErrUnsupportedKind, t)
 		return reflect.Value{}, err
 	}
 
 }
 
-func (f *FakeGenerator) isExcluded(fieldname string) bool {
+// setField fills the i-th field of the struct v, whose original value is a, according to its tags.
+func (f *FakeGenerator) setField(ctx context.Context, a interface{}, v reflect.Value, i int, tags structTag) error {
+	if tags.keepOriginal || f.fillZeroOnly {
+		if original := reflect.ValueOf(a).Field(i); !isZero(original) {
+			return f.keepField(ctx, original, v.Field(i), tags)
+		}
+	}
+	switch {
+	case tags.derive != nil:
+		return f.deriveField(ctx, v, i, tags.derive)
+	case tags.fieldType == "":
+		val, err := f.newValue(ctx, v.Field(i).Type())
+		if err != nil {
+			return err
+		}
+		v.Field(i).Set(val.Convert(v.Field(i).Type()))
+	case tags.fieldType == SKIP:
+	case f.isRandomNil(ctx, v.Field(i).Type()):
+	default:
+		return f.setDataWithTag(ctx, v.Field(i).Addr(), tags.fieldType, v.Field(i).Type())
+	}
+	return nil
+}
+
+// isRecursionLimitReached reports whether the struct type reached through the pointer, slice or map type t
+// is already nested in itself as deep as the generator allows.
+func (f *FakeGenerator) isRecursionLimitReached(ctx context.Context, t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	return structTypeDepth(ctx, t) > f.maxDepth
+}
+
+func isNilInterface(v reflect.Value) bool {
+	return v.Kind() == reflect.Interface && v.IsNil()
+}
+
+// isExcluded reports whether a field filter matches the field known by names, as returned by fieldNames.
+// Filters match anywhere in the bare field name, or the whole of a dotted name.
+func (f *FakeGenerator) isExcluded(names []string) bool {
 	for _, re := range f.fieldFilter {
-		if re.MatchString(fieldname) {
+		if re.MatchString(names[len(names)-1]) {
 			return true
 		}
 	}
+	for _, re := range f.fieldPathFilter {
+		for _, name := range names[:len(names)-1] {
+			if re.MatchString(name) {
+				return true
+			}
+		}
+	}
 	return false
 }
 
-func (f *FakeGenerator) isZero(field reflect.Value) (bool, error) {
-	for _, kind := range []reflect.Kind{reflect.Struct, reflect.Slice, reflect.Array, reflect.Map} {
-		if kind == field.Kind() {
-			return false, fmt.Errorf("keep not allowed on struct")
+// fullTag returns the faker tag of field i of typ, followed by the tag added with AddFieldTag for the
+// field known by names, if any.
+func (f *FakeGenerator) fullTag(typ reflect.Type, i int, names []string) string {
+	tag := typ.Field(i).Tag.Get(tagName)
+	if extra, found := f.fieldTag(names); found {
+		if tag == "" {
+			return extra
 		}
+		return tag + comma + extra
 	}
-	return reflect.Zero(field.Type()).Interface() == field.Interface(), nil
+	return tag
 }
 
-func (f *FakeGenerator) decodeTags(typ reflect.Type, i int) structTag {
-	tags := strings.Split(typ.Field(i).Tag.Get(tagName), ",")
-
-	keepOriginal := false
-	res := make([]string, 0)
-	for _, tag := range tags {
-		if tag == keep {
-			keepOriginal = true
-			continue
+func (f *FakeGenerator) decodeTags(typ reflect.Type, i int, names []string) (structTag, error) {
+	spec, err := parseTag(typ.Field(i).Tag.Get(tagName))
+	if err != nil {
+		return structTag{}, err
+	}
+	if tag, found := f.fieldTag(names); found {
+		extra, err := parseTag(tag)
+		if err != nil {
+			return structTag{}, err
 		}
-		if tag != "" {
-			res = append(res, tag)
+		if spec, err = spec.merge(extra, tag); err != nil {
+			return structTag{}, err
 		}
 	}
-	tag, found := f.fieldTags[typ.Field(i).Name]
-	if found {
-		res = append(res, tag)
-	}
 
 	return structTag{
-		fieldType:    strings.Join(res, ","),
-		keepOriginal: keepOriginal,
-	}
+		tag:            f.fullTag(typ, i, names),
+		fieldType:      spec.fieldType(),
+		provider:       spec.provider,
+		keepOriginal:   spec.keepOriginal,
+		unique:         spec.unique,
+		nullable:       spec.nullable,
+		nilProbability: spec.nilProbability,
+		size:           spec.size,
+		derive:         spec.derive,
+	}, nil
 }
 
 type structTag struct {
-	fieldType    string
-	keepOriginal bool
+	tag            string
+	fieldType      string
+	provider       string
+	keepOriginal   bool
+	unique         bool
+	nullable       bool
+	nilProbability float64
+	size           *sizeRange
+	derive         *derivation
 }
 
 func (f *FakeGenerator) setDataWithTag(ctx context.Context, v reflect.Value, tag string, typ reflect.Type) error {
 
 	if v.Kind() != reflect.Ptr {
-		return errors.New(ErrValueNotPtr)
+		return ErrValueNotPtr
 	}
 	v = reflect.Indirect(v)
 	switch v.Kind() {
 	case reflect.Ptr:
 		if _, exist := f.tagProviders[tag]; !exist {
-			return errors.New(ErrTagNotSupported)
+			return ErrTagNotSupported
 		}
+		t := v.Type()
+		newv := reflect.New(t.Elem())
 		if _, def := defaultTag[tag]; !def {
 			res, err := f.tagProviders[tag](ctx, v)
 			if err != nil {
 				return err
 			}
+			if ok, err := setFromProvider(newv.Elem(), res); ok {
+				v.Set(newv)
+				return err
+			}
 			v.Set(reflect.ValueOf(res))
 			return nil
 		}
 
-		t := v.Type()
-		newv := reflect.New(t.Elem())
 		res, err := f.tagProviders[tag](ctx, newv.Elem())
 		if err != nil {
 			return err
 		}
+		if ok, err := setFromProvider(newv.Elem(), res); ok {
+			v.Set(newv)
+			return err
+		}
 		rval := reflect.ValueOf(res)
 		newv.Elem().Set(rval)
 		v.Set(newv)
@@ -594,13 +1122,16 @@ func (f *FakeGenerator) setDataWithTag(ctx context.Context, v reflect.Value, tag
 		return f.userDefinedMap(ctx, v, tag)
 	default:
 		if _, exist := f.tagProviders[tag]; !exist {
-			return errors.New(ErrTagNotSupported)
+			return ErrTagNotSupported
 		}
 		res, err := f.tagProviders[tag](ctx, v)
 		if err != nil {
 			return err
 		}
 
+		if ok, err := setFromProvider(v, res); ok {
+			return err
+		}
 		valMap, ok := res.(map[interface{}]interface{})
 		if ok {
 			newFaker := f.clone(valMap)
@@ -612,20 +1143,24 @@ func (f *FakeGenerator) setDataWithTag(ctx context.Context, v reflect.Value, tag
 			v.Set(val)
 
 		} else {
-			v.Set(reflect.ValueOf(res).Convert(typ))
+			val := reflect.ValueOf(res)
+			if !val.IsValid() || !val.Type().ConvertibleTo(typ) {
+				return fmt.Errorf("%w: %T can not be used as %s", ErrWrongProviderType, res, typ)
+			}
+			v.Set(val.Convert(typ))
 		}
 	}
 	return nil
 }
 
 func (f *FakeGenerator) userDefinedMap(ctx context.Context, v reflect.Value, tag string) error {
-	len := f.randomSliceAndMapSize()
+	len := f.randomSliceAndMapSize(ctx, v.Type())
 	if f.shouldSetNil && len == 0 {
 		v.Set(reflect.Zero(v.Type()))
 		return nil
 	}
 	definedMap := reflect.MakeMap(v.Type())
-	for i := 0; i < len; i++ {
+	for i := 0; definedMap.Len() < len && i < len*mapKeyAttempts; i++ {
 		key, err := f.getValueWithTag(ctx, v.Type().Key(), tag)
 		if err != nil {
 			return err
@@ -643,20 +1178,30 @@ func (f *FakeGenerator) userDefinedMap(ctx context.Context, v reflect.Value, tag
 func (f *FakeGenerator) getValueWithTag(ctx context.Context, t reflect.Type, tag string) (interface{}, error) {
 	switch t.Kind() {
 	case reflect.Int, reflect.Int32, reflect.Int64, reflect.Int8, reflect.Int16, reflect.Uint, reflect.Uint8,
-		reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		res, err := f.extractNumberFromTag(tag, t)
+		reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		res, err := f.extractNumberFromTag(ctx, tag, t)
 		if err != nil {
 			return nil, err
 		}
 		return res, nil
 	case reflect.String:
-		res, err := f.extractStringFromTag(tag)
+		res, err := f.extractStringFromTag(ctx, tag)
 		if err != nil {
 			return nil, err
 		}
 		return res, nil
+	case reflect.Array:
+		array := reflect.New(t).Elem()
+		for i := 0; i < array.Len(); i++ {
+			res, err := f.getValueWithTag(ctx, t.Elem(), tag)
+			if err != nil {
+				return nil, err
+			}
+			array.Index(i).Set(reflect.ValueOf(res).Convert(t.Elem()))
+		}
+		return array.Interface(), nil
 	default:
-		return 0, errors.New(ErrUnknownType)
+		return 0, ErrUnknownType
 	}
 }
 
@@ -666,14 +1211,17 @@ func (f *FakeGenerator) userDefinedArray(ctx context.Context, v reflect.Value, t
 		if err != nil {
 			return err
 		}
+		if ok, err := setFromProvider(v, res); ok {
+			return err
+		}
 
 		contentList, ok := res.([]interface{})
 		if !ok {
-			return errors.New("value set needs to be an array for tag " + tag)
+			return f.userDefinedArrayElements(ctx, v, tag, tagFunc)
 		}
 
-		array := reflect.MakeSlice(v.Type(), len(contentList), len(contentList))
-		for i := 0; i < len(contentList); i++ {
+		array := makeList(v.Type(), len(contentList))
+		for i := 0; i < array.Len() && i < len(contentList); i++ {
 			mapVals, ok := contentList[i].(map[interface{}]interface{})
 			if ok {
 				newFaker := f.clone(mapVals)
@@ -690,7 +1238,16 @@ func (f *FakeGenerator) userDefinedArray(ctx context.Context, v reflect.Value, t
 		return nil
 	}
 
-	len := f.randomSliceAndMapSize()
+	if v.Kind() == reflect.Array {
+		res, err := f.getValueWithTag(ctx, v.Type(), tag)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(res))
+		return nil
+	}
+
+	len := f.randomSliceAndMapSize(ctx, v.Type())
 	if f.shouldSetNil && len == 0 {
 		v.Set(reflect.Zero(v.Type()))
 		return nil
@@ -701,14 +1258,81 @@ func (f *FakeGenerator) userDefinedArray(ctx context.Context, v reflect.Value, t
 		if err != nil {
 			return err
 		}
-		array.Index(i).Set(reflect.ValueOf(res))
+		array.Index(i).Set(reflect.ValueOf(res).Convert(v.Type().Elem()))
+	}
+	v.Set(array)
+	return nil
+}
+
+// userDefinedArrayElements fills the slice or array v with one value of the provider of tag per element,
+// for providers of single values such as email.
+func (f *FakeGenerator) userDefinedArrayElements(ctx context.Context, v reflect.Value, tag string, tagFunc TaggedFunction) error {
+	size := 0
+	if v.Kind() == reflect.Slice {
+		size = f.randomSliceAndMapSize(ctx, v.Type())
+		if f.shouldSetNil && size == 0 {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+	}
+	elemType := v.Type().Elem()
+	array := makeList(v.Type(), size)
+	for i := 0; i < array.Len(); i++ {
+		res, err := tagFunc(ctx, reflect.New(elemType).Elem())
+		if err != nil {
+			return err
+		}
+		if ok, err := setFromProvider(array.Index(i), res); ok {
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		val := reflect.ValueOf(res)
+		if !val.IsValid() || !val.Type().ConvertibleTo(elemType) || (elemType.Kind() == reflect.String && val.Kind() != reflect.String) {
+			return fmt.Errorf("%w: %T can not be used as %s for tag %q", ErrWrongProviderType, res, elemType, tag)
+		}
+		array.Index(i).Set(val.Convert(elemType))
 	}
 	v.Set(array)
 	return nil
 }
 
+// makeList returns a slice of size elements for slice types and a zero array for array types,
+// whose length is fixed by the type.
+func makeList(t reflect.Type, size int) reflect.Value {
+	if t.Kind() == reflect.Array {
+		return reflect.New(t).Elem()
+	}
+	return reflect.MakeSlice(t, size, size)
+}
+
 func (f *FakeGenerator) clone(customMappings map[interface{}]interface{}) *FakeGenerator {
 	newFaker := NewFakeGenerator()
+	newFaker.rand, newFaker.seed, newFaker.referenceTime = f.rand, f.seed, f.referenceTime
+	newFaker.maxDepth = f.maxDepth
+	newFaker.uniqueValues, newFaker.uniqueRetries = f.uniqueValues, f.uniqueRetries
+	newFaker.nilProbability = f.nilProbability
+	newFaker.fillZeroOnly = f.fillZeroOnly
+	newFaker.minRandomSize, newFaker.fBoundary = f.minRandomSize, f.fBoundary
+	newFaker.fullNumberRange, newFaker.edgeProbability = f.fullNumberRange, f.edgeProbability
+	for typ, probability := range f.typeNilProbability {
+		newFaker.typeNilProbability[typ] = probability
+	}
+	for tag := range f.uniqueTags {
+		newFaker.uniqueTags[tag] = true
+	}
+	for name, fn := range f.derivers {
+		newFaker.derivers[name] = fn
+	}
+	newFaker.beforeFieldHooks = append(newFaker.beforeFieldHooks, f.beforeFieldHooks...)
+	newFaker.afterValueHooks = append(newFaker.afterValueHooks, f.afterValueHooks...)
+	for iface, impls := range f.interfaceImpls {
+		newFaker.interfaceImpls[iface] = impls
+	}
+	for typ, provider := range f.typeProviders {
+		newFaker.typeProviders[typ] = provider
+	}
 	for key, val := range f.tagProviders {
 		newFaker.tagProviders[key] = val
 		newFaker.fieldTags[key] = key
@@ -716,6 +1340,9 @@ func (f *FakeGenerator) clone(customMappings map[interface{}]interface{}) *FakeG
 	for _, r := range f.fieldFilter {
 		newFaker.fieldFilter = append(newFaker.fieldFilter, r)
 	}
+	for _, r := range f.fieldPathFilter {
+		newFaker.fieldPathFilter = append(newFaker.fieldPathFilter, r)
+	}
 	for ftk, ftv := range f.fieldTags {
 		newFaker.fieldTags[ftk] = ftv
 	}
@@ -738,15 +1365,18 @@ func (f *FakeGenerator) userDefinedString(ctx context.Context, v reflect.Value,
 			return err
 		}
 	} else {
-		res, err = f.extractStringFromTag(tag)
+		res, err = f.extractStringFromTag(ctx, tag)
 		if err != nil {
 			return err
 		}
 	}
 	if res == nil {
-		return errors.New(ErrTagNotSupported)
+		return ErrTagNotSupported
 	}
 	val, _ := res.(string)
+	if ok, err := setFromString(v, val); ok {
+		return err
+	}
 	v.SetString(val)
 	return nil
 }
@@ -760,30 +1390,44 @@ func (f *FakeGenerator) userDefinedNumber(ctx context.Context, v reflect.Value,
 		if err != nil {
 			return err
 		}
+		if ok, err := setFromProvider(v, res); ok {
+			return err
+		}
 		res = f.castNumber(res, v.Type())
 	} else {
-		res, err = f.extractNumberFromTag(tag, v.Type())
+		res, err = f.extractNumberFromTag(ctx, tag, v.Type())
 		if err != nil {
 			return err
 		}
 	}
 	if res == nil {
-		return errors.New(ErrTagNotSupported)
+		return ErrTagNotSupported
 	}
 
 	v.Set(reflect.ValueOf(res).Convert(typ))
 	return nil
 }
 
-func (f *FakeGenerator) extractStringFromTag(tag string) (interface{}, error) {
+func (f *FakeGenerator) extractStringFromTag(ctx context.Context, tag string) (interface{}, error) {
+	if strings.HasPrefix(tag, Regex+Equals) {
+		return randomStringMatching(RandFromContext(ctx), strings.TrimPrefix(tag, Regex+Equals))
+	}
+	if strings.HasPrefix(tag, OneOf+Equals) {
+		return f.extractOneOfFromTag(ctx, tag, reflect.TypeOf(""))
+	}
 	if !strings.Contains(tag, Length) {
-		return nil, errors.New(ErrTagNotSupported)
+		return nil, ErrTagNotSupported
 	}
-	len, err := f.extractNumberFromText(tag)
+	texts := strings.SplitN(strings.TrimSpace(tag), Equals, -1)
+	if len(texts) != 2 {
+		return nil, fmt.Errorf("%w: %q", ErrWrongFormattedTag, tag)
+	}
+	size, err := parseSizeRange(texts[1])
 	if err != nil {
 		return nil, err
 	}
-	res := RandomString(len)
+	r := RandFromContext(ctx)
+	res := randomString(r, randomSize(r, size))
 	return res, nil
 }
 
@@ -817,63 +1461,108 @@ func (f *FakeGenerator) castNumber(val interface{}, t reflect.Type) interface{}
 	return val
 }
 
-func (f *FakeGenerator) extractNumberFromTag(tag string, t reflect.Type) (interface{}, error) {
-	if !strings.Contains(tag, BoundaryStart) || !strings.Contains(tag, BoundaryEnd) {
-		return nil, errors.New(ErrTagNotSupported)
-	}
-	valuesStr := strings.SplitN(tag, comma, -1)
-	if len(valuesStr) != 2 {
-		return nil, fmt.Errorf(ErrWrongFormattedTag, tag)
+func (f *FakeGenerator) extractNumberFromTag(ctx context.Context, tag string, t reflect.Type) (interface{}, error) {
+	if strings.HasPrefix(tag, OneOf+Equals) {
+		return f.extractOneOfFromTag(ctx, tag, t)
 	}
-	startBoundary, err := f.extractNumberFromText(valuesStr[0])
-	if err != nil {
-		return nil, err
+	if t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64 {
+		return f.extractFloatFromTag(ctx, tag, t)
 	}
-	endBoundary, err := f.extractNumberFromText(valuesStr[1])
-	if err != nil {
-		return nil, err
-	}
-	boundary := numberBoundary{start: startBoundary, end: endBoundary}
 	switch t.Kind() {
-	case reflect.Uint:
-		return uint(RandomIntegerWithBoundary(boundary)), nil
-	case reflect.Uint8:
-		return uint8(RandomIntegerWithBoundary(boundary)), nil
-	case reflect.Uint16:
-		return uint16(RandomIntegerWithBoundary(boundary)), nil
-	case reflect.Uint32:
-		return uint32(RandomIntegerWithBoundary(boundary)), nil
-	case reflect.Uint64:
-		return uint64(RandomIntegerWithBoundary(boundary)), nil
-	case reflect.Int:
-		return RandomIntegerWithBoundary(boundary), nil
-	case reflect.Int8:
-		return int8(RandomIntegerWithBoundary(boundary)), nil
-	case reflect.Int16:
-		return int16(RandomIntegerWithBoundary(boundary)), nil
-	case reflect.Int32:
-		return int32(RandomIntegerWithBoundary(boundary)), nil
-	case reflect.Int64:
-		return int64(RandomIntegerWithBoundary(boundary)), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return f.extractIntegerFromTag(ctx, tag, t)
 	default:
-		return nil, errors.New(ErrNotSupportedTypeForTag)
+		return nil, ErrNotSupportedTypeForTag
 	}
 }
 
-func (f *FakeGenerator) extractNumberFromText(text string) (int, error) {
-	text = strings.TrimSpace(text)
-	texts := strings.SplitN(text, Equals, -1)
-	if len(texts) != 2 {
-		return 0, fmt.Errorf(ErrWrongFormattedTag, text)
+// extractFloatFromTag generates a float within the decimal boundary_start and boundary_end of tag, or the
+// generator's float boundaries, rounded to the number of decimals set by precision when present.
+func (f *FakeGenerator) extractFloatFromTag(ctx context.Context, tag string, t reflect.Type) (interface{}, error) {
+	boundary := f.floatBoundary()
+	precision := -1
+	var hasStart, hasEnd bool
+	for _, option := range strings.Split(tag, comma) {
+		texts := strings.SplitN(strings.TrimSpace(option), Equals, -1)
+		if len(texts) != 2 {
+			return nil, fmt.Errorf("%w: %q", ErrWrongFormattedTag, tag)
+		}
+		var err error
+		switch texts[0] {
+		case BoundaryStart:
+			boundary.start, err = strconv.ParseFloat(texts[1], 64)
+			hasStart = true
+		case BoundaryEnd:
+			boundary.end, err = strconv.ParseFloat(texts[1], 64)
+			hasEnd = true
+		case Precision:
+			precision, err = strconv.Atoi(texts[1])
+			if err == nil && precision < 0 {
+				return nil, fmt.Errorf("%w: %d", ErrSmallerThanZero, precision)
+			}
+		default:
+			return nil, ErrTagNotSupported
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrWrongFormattedTag, tag)
+		}
+	}
+	if hasStart != hasEnd {
+		return nil, fmt.Errorf("%w: %q", ErrWrongFormattedTag, tag)
+	}
+	if boundary.start > boundary.end {
+		return nil, ErrStartValueBiggerThanEnd
+	}
+
+	res := randomFloatWithBoundary(RandFromContext(ctx), boundary)
+	if f.isEdgeValue(ctx) {
+		res = edgeFloat(RandFromContext(ctx), boundary)
+	}
+	if precision >= 0 {
+		res = roundFloat(res, precision)
+	}
+	if t.Kind() == reflect.Float32 {
+		return float32(res), nil
+	}
+	return res, nil
+}
+
+// parseSizeRange parses a size like "5" or "2..5" as used by the len and size tags.
+func parseSizeRange(text string) (sizeRange, error) {
+	bounds := strings.Split(strings.TrimSpace(text), "..")
+	if len(bounds) > 2 {
+		return sizeRange{}, fmt.Errorf("%w: %q", ErrWrongFormattedTag, text)
+	}
+	min, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+	if err != nil {
+		return sizeRange{}, fmt.Errorf("%w: %q", ErrWrongFormattedTag, text)
+	}
+	max := min
+	if len(bounds) == 2 {
+		if max, err = strconv.Atoi(strings.TrimSpace(bounds[1])); err != nil {
+			return sizeRange{}, fmt.Errorf("%w: %q", ErrWrongFormattedTag, text)
+		}
+	}
+	if min < 0 {
+		return sizeRange{}, fmt.Errorf("%w: %d", ErrSmallerThanZero, min)
+	}
+	if min > max {
+		return sizeRange{}, ErrStartValueBiggerThanEnd
 	}
-	return strconv.Atoi(texts[1])
+	return sizeRange{min: min, max: max}, nil
 }
 
+// RandomString returns a random string of n letters.
 func RandomString(n int) string {
+	return randomString(globalRand, n)
+}
+
+func randomString(r *rand.Rand, n int) string {
 	b := make([]byte, n)
-	for i, cache, remain := n-1, rand.Int63(), letterIdxMax; i >= 0; {
+	for i, cache, remain := n-1, r.Int63(), letterIdxMax; i >= 0; {
 		if remain == 0 {
-			cache, remain = rand.Int63(), letterIdxMax
+			cache, remain = r.Int63(), letterIdxMax
 		}
 		if idx := int(cache & letterIdxMask); idx < len(letterBytes) {
 			b[i] = letterBytes[idx]
@@ -888,7 +1577,24 @@ func RandomString(n int) string {
 
 // RandomIntegerWithBoundary returns a random integer between input start and end boundary. [start, end)
 func RandomIntegerWithBoundary(boundary numberBoundary) int {
-	return rand.Intn(boundary.end-boundary.start) + boundary.start
+	return randomIntegerWithBoundary(globalRand, boundary)
+}
+
+func randomIntegerWithBoundary(r *rand.Rand, boundary numberBoundary) int {
+	if boundary.end <= boundary.start {
+		return boundary.start
+	}
+	return int(int64(boundary.start) + int64(randomUint64n(r, uint64(boundary.end)-uint64(boundary.start)-1)))
+}
+
+func randomFloatWithBoundary(r *rand.Rand, boundary floatBoundary) float64 {
+	return boundary.start + r.Float64()*(boundary.end-boundary.start)
+}
+
+// roundFloat rounds f to the given number of decimals.
+func roundFloat(f float64, precision int) float64 {
+	pow := math.Pow(10, float64(precision))
+	return math.Round(f*pow) / pow
 }
 
 // RandomInteger returns a random integer between start and end boundary. [start, end)
@@ -896,23 +1602,47 @@ func RandomInteger() int {
 	return rand.Int()
 }
 
-// RandomSliceAndMapSize returns a random integer between [0,RandomSliceAndMapSize). If the testRandZero is set, returns 0
+// RandomSliceAndMapSize returns a random integer between [minRandomSize,RandomSliceAndMapSize), or within the
+// size tag of the field when t is the type of the field being generated. If the testRandZero is set, returns 0
 // Written for test purposes for shouldSetNil
-func (f *FakeGenerator) randomSliceAndMapSize() int {
+func (f *FakeGenerator) randomSliceAndMapSize(ctx context.Context, t reflect.Type) int {
+	if tags, ok := fieldTagsFromContext(ctx, t); ok && tags.size != nil {
+		return randomSize(RandFromContext(ctx), *tags.size)
+	}
 	if f.testRandZero {
 		return 0
 	}
-	return rand.Intn(f.randomSize)
+	if f.randomSize <= f.minRandomSize {
+		return f.minRandomSize
+	}
+	return f.minRandomSize + RandFromContext(ctx).Intn(f.randomSize-f.minRandomSize)
+}
+
+// randomSize returns a random size within size, both ends included.
+func randomSize(r *rand.Rand, size sizeRange) int {
+	if size.min == size.max {
+		return size.min
+	}
+	return size.min + r.Intn(size.max-size.min+1)
 }
 
 func RandomElementFromSliceString(s []string) string {
-	return s[rand.Int()%len(s)]
+	return randomElementFromSliceString(globalRand, s)
 }
+
+func randomElementFromSliceString(r *rand.Rand, s []string) string {
+	return s[r.Int()%len(s)]
+}
+
 func RandomStringNumber(n int) string {
+	return randomStringNumber(globalRand, n)
+}
+
+func randomStringNumber(r *rand.Rand, n int) string {
 	b := make([]byte, n)
-	for i, cache, remain := n-1, rand.Int63(), letterIdxMax; i >= 0; {
+	for i, cache, remain := n-1, r.Int63(), letterIdxMax; i >= 0; {
 		if remain == 0 {
-			cache, remain = rand.Int63(), letterIdxMax
+			cache, remain = r.Int63(), letterIdxMax
 		}
 		if idx := int(cache & letterIdxMask); idx < len(numberBytes) {
 			b[i] = numberBytes[idx]
@@ -930,22 +1660,26 @@ func RandomStringNumber(n int) string {
 // 		If only set two parameters : First this is min digit and second max digit and the total number the difference between them
 // 		If only three parameters: the third argument set Max count Digit
 func RandomInt(parameters ...int) (p []int, err error) {
+	return randomInt(globalRand, parameters...)
+}
+
+func randomInt(r *rand.Rand, parameters ...int) (p []int, err error) {
 	switch len(parameters) {
 	case 1:
 		minCount := parameters[0]
-		p = rand.Perm(minCount)
+		p = r.Perm(minCount)
 		for i := range p {
 			p[i] += minCount
 		}
 	case 2:
 		minDigit, maxDigit := parameters[0], parameters[1]
-		p = rand.Perm(maxDigit - minDigit + 1)
+		p = r.Perm(maxDigit - minDigit + 1)
 
 		for i := range p {
 			p[i] += minDigit
 		}
 	default:
-		err = fmt.Errorf(ErrMoreArguments, len(parameters))
+		err = fmt.Errorf("%w: (%d)", ErrMoreArguments, len(parameters))
 	}
 	return p, err
 }
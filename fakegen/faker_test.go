@@ -2,10 +2,19 @@ package fakegen
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
 	"math/rand"
+	"net"
+	"net/url"
 	"reflect"
+	"regexp"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -295,13 +304,21 @@ func TestFakerData(t *testing.T) {
 
 }
 
-func TestUnsuportedMapStringInterface(t *testing.T) {
+func TestMapStringInterface(t *testing.T) {
 	type Sample struct {
 		Map map[string]interface{}
 	}
 	var sample = new(Sample)
-	if err := NewFakeGenerator().FakeData(context.Background(), sample); err == nil {
-		t.Error("Expected Error. But got nil")
+	generator := NewFakeGenerator()
+	if err := generator.FakeData(context.Background(), sample); err != nil {
+		t.Error("Expected Not Error, But Got: ", err)
+	}
+	for key, value := range sample.Map {
+		switch value.(type) {
+		case string, float64, bool, []interface{}, map[string]interface{}:
+		default:
+			t.Errorf("expected a JSON like value for %s but got %T", key, value)
+		}
 	}
 }
 
@@ -477,7 +494,7 @@ func TestBoundaryAndLen(t *testing.T) {
 
 func TestExtractNumberFromTagFail(t *testing.T) {
 	notSupportedTypeStruct := &struct {
-		Test float32 `faker:"boundary_start=5, boundary_end=10"`
+		Test bool `faker:"boundary_start=5, boundary_end=10"`
 	}{}
 	generator := NewFakeGenerator()
 	if err := generator.FakeData(context.Background(), &notSupportedTypeStruct); err == nil {
@@ -587,7 +604,7 @@ func TestRandomIntOnlySecondParameters(t *testing.T) {
 func TestRandomIntOnlyError(t *testing.T) {
 	arguments := []int{1, 3, 4, 5, 6}
 	_, err := RandomInt(arguments...)
-	if err == nil && err.Error() == fmt.Errorf(ErrMoreArguments, len(arguments)).Error() {
+	if !errors.Is(err, ErrMoreArguments) {
 		t.Error("Expected error from function RandomInt")
 	}
 }
@@ -779,7 +796,7 @@ func TestTagAlreadyExists(t *testing.T) {
 		return nil, nil
 	})
 
-	if err == nil || err.Error() != ErrTagAlreadyExists {
+	if !errors.Is(err, ErrTagAlreadyExists) {
 		t.Error("Expected ErrTagAlreadyExists Error,  But Got: ", err)
 	}
 }
@@ -944,34 +961,125 @@ func TestFieldExternalTag(t *testing.T) {
 	}
 }
 
-func TestItThrowsAnErrorWhenKeepIsUsedOnIncomparableType(t *testing.T) {
-	type TypeStructWithStruct struct {
-		Struct struct{} `faker:"first_name_male,keep"`
+func TestKeepOnCompositeTypes(t *testing.T) {
+	type Address struct {
+		City string
+		Zip  string
 	}
-	type TypeStructWithMap struct {
-		Map map[string]string `faker:"first_name_male,keep"`
+	type Profile struct {
+		Address Address           `faker:"keep"`
+		Home    *Address          `faker:"keep"`
+		Labels  map[string]string `faker:"keep"`
+		Nicks   []string          `faker:"first_name_male,keep"`
+		Scores  [3]int            `faker:"keep"`
+		Other   []string          `faker:"first_name_male,keep,size=2"`
 	}
-	type TypeStructWithSlice struct {
-		Slice []string `faker:"first_name_male,keep"`
+	profile := Profile{
+		Address: Address{City: "Oslo"},
+		Home:    &Address{Zip: "0150"},
+		Labels:  map[string]string{"tier": "gold"},
+		Nicks:   []string{"kept"},
+		Scores:  [3]int{1, 0, 0},
 	}
-	type TypeStructWithArray struct {
-		Array [4]string `faker:"first_name_male,keep"`
+	if err := NewFakeGenerator().FakeData(context.Background(), &profile); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
 	}
+	if profile.Address != (Address{City: "Oslo"}) || *profile.Home != (Address{Zip: "0150"}) || profile.Labels["tier"] != "gold" ||
+		len(profile.Labels) != 1 || len(profile.Nicks) != 1 || profile.Nicks[0] != "kept" || profile.Scores != [3]int{1, 0, 0} {
+		t.Errorf("Expected the composite values to be kept, But Got: %+v", profile)
+	}
+	if len(profile.Other) == 0 {
+		t.Error("Expected the empty slice to be generated, But Got: ", profile.Other)
+	}
+}
 
-	withStruct := TypeStructWithStruct{}
-	withMap := TypeStructWithMap{}
-	withSlice := TypeStructWithSlice{}
-	withArray := TypeStructWithArray{}
+func TestFillZeroOnly(t *testing.T) {
+	type Address struct {
+		City string
+		Zip  string
+	}
+	type Item struct {
+		SKU   string
+		Count int
+	}
+	type Order struct {
+		ID       string
+		Email    string `faker:"email"`
+		Created  time.Time
+		Shipping Address
+		Billing  *Address
+		Items    []Item
+		Notes    []string
+		Pinned   Address `faker:"keep"`
+	}
+	created := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	billing := &Address{Zip: "0150"}
+	order := Order{
+		ID:       "order-1",
+		Created:  created,
+		Shipping: Address{City: "Oslo"},
+		Billing:  billing,
+		Items:    []Item{{SKU: "sku-1"}, {}},
+		Notes:    []string{""},
+		Pinned:   Address{City: "Bergen"},
+	}
+	generator := NewFakeGenerator()
+	generator.SetFillZeroOnly(true)
+	if err := generator.FakeData(context.Background(), &order); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if order.ID != "order-1" || !order.Created.Equal(created) || order.Shipping.City != "Oslo" || order.Billing.Zip != "0150" ||
+		order.Items[0].SKU != "sku-1" || len(order.Items) != 2 || len(order.Notes) != 1 || order.Notes[0] != "" ||
+		order.Pinned != (Address{City: "Bergen"}) {
+		t.Errorf("Expected the values set to be kept, But Got: %+v", order)
+	}
+	if order.Email == "" || order.Shipping.Zip == "" || order.Billing.City == "" || order.Items[1].SKU == "" {
+		t.Errorf("Expected the zero fields to be generated, But Got: %+v", order)
+	}
+	if order.Billing != billing {
+		t.Errorf("Expected the billing address to be filled in place, But Got: %p instead of %p", order.Billing, billing)
+	}
 
-	for _, item := range []interface{}{withArray, withStruct, withMap, withSlice} {
-		err := NewFakeGenerator().FakeData(context.Background(), &item)
-		if err == nil {
-			t.Errorf("expected error, but got nil")
-		}
+	var empty Order
+	if err := generator.FakeData(context.Background(), &empty); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if empty.ID == "" || empty.Shipping.City == "" || empty.Billing == nil {
+		t.Errorf("Expected a zero value to be generated, But Got: %+v", empty)
+	}
+}
+
+type FillNode struct {
+	Name  string
+	Next  *FillNode
+	Inner **FillInner
+}
+
+type FillInner struct {
+	Code string
+}
+
+func TestFillZeroOnlyPointers(t *testing.T) {
+	generator := NewFakeGenerator()
+	generator.SetFillZeroOnly(true)
+
+	a := &FillNode{Name: "a"}
+	b := &FillNode{Next: a}
+	a.Next = b
+	inner := new(*FillInner)
+	a.Inner = inner
+	if err := generator.FakeData(context.Background(), a); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if a.Name != "a" || a.Next != b || b.Next != a || a.Inner != inner {
+		t.Errorf("Expected the cycle to be kept, But Got: %+v and %+v", a, b)
+	}
+	if b.Name == "" || *inner == nil || (*inner).Code == "" {
+		t.Errorf("Expected the zero values to be generated, But Got: %+v and %+v", b, *inner)
 	}
 }
 
-func TestItThrowsAnErrorWhenPointerToInterfaceIsUsed(t *testing.T) {
+func TestPointerToInterface(t *testing.T) {
 	type PtrToInterface struct {
 		Interface *interface{}
 	}
@@ -979,8 +1087,11 @@ func TestItThrowsAnErrorWhenPointerToInterfaceIsUsed(t *testing.T) {
 	interfacePtr := PtrToInterface{}
 
 	err := NewFakeGenerator().FakeData(context.Background(), &interfacePtr)
-	if err == nil {
-		t.Errorf("expected error, but got nil")
+	if err != nil {
+		t.Error("Expected Not Error, But Got: ", err)
+	}
+	if interfacePtr.Interface == nil || *interfacePtr.Interface == nil {
+		t.Error("expected filled but got empty")
 	}
 }
 
@@ -1115,6 +1226,58 @@ func TestCustomMapping(t *testing.T) {
 
 type Amount int32
 
+func TestCustomMappingKeepsNumberSettings(t *testing.T) {
+	type Inner struct {
+		Name  string
+		Big   int64
+		Ratio float64
+		List  []int
+	}
+	type Outer struct {
+		Inner Inner `faker:"inner"`
+	}
+	generator := NewFakeGenerator(WithSeed(9))
+	err := generator.AddProvider("inner", func(ctx context.Context, v reflect.Value) (interface{}, error) {
+		return map[interface{}]interface{}{"Name": "mapped"}, nil
+	})
+	if err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	generator.SetFullNumberRange(true)
+	if err := generator.SetRandomFloatBoundaries(5, 6); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if err := generator.SetMinRandomMapAndSliceSize(3); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+
+	wide := false
+	for i := 0; i < 20; i++ {
+		var outer Outer
+		if err := generator.FakeData(context.Background(), &outer); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		if outer.Inner.Name != "mapped" || outer.Inner.Ratio < 5 || outer.Inner.Ratio >= 6 || len(outer.Inner.List) < 3 {
+			t.Errorf("Expected the settings of the generator to apply, But Got: %+v", outer.Inner)
+		}
+		wide = wide || outer.Inner.Big < 0 || outer.Inner.Big >= 100
+	}
+	if !wide {
+		t.Error("Expected integers over the full range of int64")
+	}
+
+	if err := generator.SetEdgeValueProbability(1); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	var outer Outer
+	if err := generator.FakeData(context.Background(), &outer); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if big := outer.Inner.Big; big != 0 && big != -1 && big != math.MinInt64 && big != math.MaxInt64 {
+		t.Error("Expected an edge int64, But Got: ", big)
+	}
+}
+
 type Pagination struct {
 	PageNum  int
 	PageSize int
@@ -1134,3 +1297,1748 @@ type ServiceRequest struct {
 	Page      Pagination
 	Amt       Amount
 }
+
+func TestSeededGeneratorIsDeterministic(t *testing.T) {
+	referenceTime := time.Date(2020, time.July, 23, 0, 0, 0, 0, time.UTC)
+	fake := func(seed int64) (SomeStruct, TaggedStruct) {
+		generator := NewFakeGenerator(WithSeed(seed), WithReferenceTime(referenceTime))
+		var some SomeStruct
+		if err := generator.FakeData(context.Background(), &some); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		var tagged TaggedStruct
+		if err := generator.FakeData(context.Background(), &tagged); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		return some, tagged
+	}
+
+	someA, taggedA := fake(42)
+	someB, taggedB := fake(42)
+	if !reflect.DeepEqual(someA, someB) {
+		t.Errorf("expected identical values for the same seed, got %v and %v", someA, someB)
+	}
+	if !reflect.DeepEqual(taggedA, taggedB) {
+		t.Errorf("expected identical values for the same seed, got %v and %v", taggedA, taggedB)
+	}
+
+	_, taggedC := fake(43)
+	if reflect.DeepEqual(taggedA, taggedC) {
+		t.Error("expected different values for different seeds")
+	}
+}
+
+func TestReferenceTime(t *testing.T) {
+	type Event struct {
+		At       time.Time
+		Unix     int64  `faker:"unix_time"`
+		Date     string `faker:"date"`
+		Stamp    string `faker:"timestamp"`
+		Year     string `faker:"year"`
+		Weekday  string `faker:"day_of_week"`
+		Monthday string `faker:"day_of_month"`
+	}
+	fake := func(opts ...Option) Event {
+		var event Event
+		if err := NewFakeGenerator(opts...).FakeData(context.Background(), &event); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		return event
+	}
+
+	if a, b := fake(WithSeed(5)), fake(WithSeed(5)); !reflect.DeepEqual(a, b) {
+		t.Errorf("expected identical values for the same seed, got %+v and %+v", a, b)
+	}
+	instant := time.Date(2021, time.March, 4, 22, 30, 0, 0, time.UTC)
+	utc := fake(WithSeed(5), WithReferenceTime(instant))
+	tokyo := fake(WithSeed(5), WithReferenceTime(instant.In(time.FixedZone("JST", 9*60*60))))
+	if !reflect.DeepEqual(utc, tokyo) {
+		t.Errorf("expected values independent of the time zone, got %+v and %+v", utc, tokyo)
+	}
+	for _, epoch := range []time.Time{time.Unix(0, 0), time.Date(1960, time.May, 1, 0, 0, 0, 0, time.UTC)} {
+		if event := fake(WithSeed(5), WithReferenceTime(epoch)); event.Unix != epoch.Unix() {
+			t.Errorf("expected the unix time %d, But Got: %d", epoch.Unix(), event.Unix)
+		}
+	}
+}
+
+func TestSeedIsRetrievable(t *testing.T) {
+	if seed := NewFakeGenerator(WithSeed(1234)).Seed(); seed != 1234 {
+		t.Errorf("expected seed 1234 but was %d", seed)
+	}
+	if seed := NewFakeGenerator().Seed(); seed == 0 {
+		t.Error("expected a generated seed but was 0")
+	}
+	if seed := NewFakeGenerator(WithRandSource(rand.NewSource(1))).Seed(); seed != 0 {
+		t.Errorf("expected seed 0 for a custom source but was %d", seed)
+	}
+}
+
+func TestProviderContext(t *testing.T) {
+	type Sample struct {
+		Name string
+		Page Pagination
+	}
+
+	fake := func(seed int64) (Sample, []string) {
+		var paths []string
+		generator := NewFakeGenerator(WithSeed(seed))
+		err := generator.AddProvider("random-page", func(ctx context.Context, v reflect.Value) (interface{}, error) {
+			paths = append(paths, FieldPathFromContext(ctx))
+			return RandFromContext(ctx).Intn(1000), nil
+		})
+		if err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		generator.AddFieldTag("PageNum", "random-page")
+		generator.AddFieldTag("PageSize", "random-page")
+
+		var sample Sample
+		if err := generator.FakeData(context.Background(), &sample); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		return sample, paths
+	}
+
+	first, paths := fake(7)
+	second, _ := fake(7)
+	if first != second {
+		t.Errorf("expected identical values for the same seed, got %+v and %+v", first, second)
+	}
+	if !reflect.DeepEqual(paths, []string{"Page.PageNum", "Page.PageSize"}) {
+		t.Errorf("unexpected field paths %v", paths)
+	}
+}
+
+type TreeNode struct {
+	Name     string
+	Children []*TreeNode
+	Parent   *TreeNode
+	Index    map[string]TreeNode
+}
+
+func treeDepth(node *TreeNode) int {
+	depth := 0
+	for _, child := range node.Children {
+		if d := treeDepth(child); d > depth {
+			depth = d
+		}
+	}
+	for _, child := range node.Index {
+		child := child
+		if d := treeDepth(&child); d > depth {
+			depth = d
+		}
+	}
+	if node.Parent != nil {
+		if d := treeDepth(node.Parent); d > depth {
+			depth = d
+		}
+	}
+	return depth + 1
+}
+
+func TestRecursiveStruct(t *testing.T) {
+	for _, maxDepth := range []int{0, 1, 3} {
+		generator := NewFakeGenerator()
+		if err := generator.SetRandomMapAndSliceSize(3); err != nil {
+			t.Fatal(err)
+		}
+		if err := generator.SetMaxRecursionDepth(maxDepth); err != nil {
+			t.Fatal(err)
+		}
+		var node TreeNode
+		if err := generator.FakeData(context.Background(), &node); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		if depth := treeDepth(&node); depth > maxDepth+1 {
+			t.Errorf("expected at most %d nested levels but got %d", maxDepth+1, depth)
+		}
+		if maxDepth == 0 && (node.Parent != nil || len(node.Children) != 0 || len(node.Index) != 0) {
+			t.Errorf("expected no nested nodes but got %+v", node)
+		}
+	}
+
+	if err := NewFakeGenerator().SetMaxRecursionDepth(-1); err == nil {
+		t.Error("Max recursion depth must not accept lower than 0")
+	}
+}
+
+type Shape interface {
+	Area() float64
+}
+
+type Square struct {
+	Side float64
+}
+
+func (s Square) Area() float64 { return s.Side * s.Side }
+
+type Circle struct {
+	Radius float64
+}
+
+func (c *Circle) Area() float64 { return c.Radius * c.Radius * 3.14 }
+
+func TestInterfaceImplementations(t *testing.T) {
+	type Drawing struct {
+		Main   Shape
+		Shapes []Shape
+		ByName map[string]Shape
+	}
+	shapeType := reflect.TypeOf((*Shape)(nil)).Elem()
+
+	t.Run("unregistered", func(t *testing.T) {
+		var drawing Drawing
+		if err := NewFakeGenerator().FakeData(context.Background(), &drawing); err == nil {
+			t.Error("expected error, but got nil")
+		}
+	})
+
+	t.Run("registered", func(t *testing.T) {
+		generator := NewFakeGenerator()
+		if err := generator.AddInterfaceImplementation(shapeType, reflect.TypeOf(Square{})); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		if err := generator.AddWeightedInterfaceImplementation(shapeType, reflect.TypeOf(&Circle{}), 3); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		var drawing Drawing
+		if err := generator.FakeData(context.Background(), &drawing); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		shapes := append([]Shape{drawing.Main}, drawing.Shapes...)
+		for _, shape := range drawing.ByName {
+			shapes = append(shapes, shape)
+		}
+		for _, shape := range shapes {
+			switch shape.(type) {
+			case Square, *Circle:
+			default:
+				t.Errorf("expected a registered implementation but got %T", shape)
+			}
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		generator := NewFakeGenerator()
+		if err := generator.AddInterfaceImplementation(reflect.TypeOf(Square{}), reflect.TypeOf(Square{})); err == nil {
+			t.Error("expected error for a non interface type, but got nil")
+		}
+		if err := generator.AddInterfaceImplementation(shapeType, reflect.TypeOf(Circle{})); err == nil {
+			t.Error("expected error for a type not implementing the interface, but got nil")
+		}
+		if err := generator.AddWeightedInterfaceImplementation(shapeType, reflect.TypeOf(Square{}), 0); err == nil {
+			t.Error("expected error for a zero weight, but got nil")
+		}
+	})
+}
+
+type PShape interface {
+	Shape
+}
+
+type PGroup struct {
+	Children []PShape `faker:"size=2"`
+}
+
+func (g PGroup) Area() float64 {
+	area := 0.0
+	for _, child := range g.Children {
+		if child != nil {
+			area += child.Area()
+		}
+	}
+	return area
+}
+
+func groupDepth(shape PShape) int {
+	group, ok := shape.(PGroup)
+	if !ok {
+		return 0
+	}
+	depth := 0
+	for _, child := range group.Children {
+		if d := groupDepth(child); d > depth {
+			depth = d
+		}
+	}
+	return depth + 1
+}
+
+func TestRecursiveInterfaceImplementation(t *testing.T) {
+	generator := NewFakeGenerator(WithSeed(3))
+	err := generator.AddInterfaceImplementation(reflect.TypeOf((*PShape)(nil)).Elem(), reflect.TypeOf(PGroup{}))
+	if err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	var drawing struct {
+		S PShape
+	}
+	if err := generator.FakeData(context.Background(), &drawing); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if depth := groupDepth(drawing.S); depth > 2 {
+		t.Errorf("expected at most 2 nested groups but got %d", depth)
+	}
+}
+
+func TestInterfaceMapKeys(t *testing.T) {
+	var sample struct {
+		M map[interface{}]int
+	}
+	generator := NewFakeGenerator(WithSeed(11))
+	for i := 0; i < 20; i++ {
+		if err := generator.FakeData(context.Background(), &sample); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		for key := range sample.M {
+			switch key.(type) {
+			case string, float64, bool:
+			default:
+				t.Errorf("expected a hashable scalar key but got %T", key)
+			}
+		}
+	}
+}
+
+func TestFixedSizeArrays(t *testing.T) {
+	type Point struct {
+		Coordinates [2]float64
+	}
+	type Sample struct {
+		Hash      [32]byte
+		Triple    [3]int
+		Points    [2]Point
+		PointMap  map[string][2]Point
+		Codes     [3]string      `faker:"len=2"`
+		Bounded   [4]int         `faker:"boundary_start=5, boundary_end=10"`
+		BoundMap  map[int][2]int `faker:"boundary_start=5, boundary_end=10"`
+		Companies [2]string      `faker:"companies"`
+	}
+
+	generator := NewFakeGenerator()
+	err := generator.AddProvider("companies", func(ctx context.Context, v reflect.Value) (interface{}, error) {
+		return []interface{}{"company1", "company2"}, nil
+	})
+	if err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+
+	var sample Sample
+	if err := generator.FakeData(context.Background(), &sample); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if sample.Hash == [32]byte{} {
+		t.Error("expected hash to be filled")
+	}
+	for _, code := range sample.Codes {
+		if err := validateLen(code); err != nil {
+			t.Error(err)
+		}
+	}
+	for _, value := range sample.Bounded {
+		if err := validateRange(value); err != nil {
+			t.Error(err)
+		}
+	}
+	for _, values := range sample.BoundMap {
+		for _, value := range values {
+			if err := validateRange(value); err != nil {
+				t.Error(err)
+			}
+		}
+	}
+	if sample.Companies != [2]string{"company1", "company2"} {
+		t.Errorf("expected companies from the provider but got %v", sample.Companies)
+	}
+}
+
+type Cents int64
+
+func TestTypeProvider(t *testing.T) {
+	type Sample struct {
+		Price    Cents
+		Prices   []Cents
+		Fixed    [2]Cents
+		ByCents  map[Cents]Cents
+		Optional *Cents
+		Tagged   Cents `faker:"boundary_start=5, boundary_end=10"`
+	}
+	centsType := reflect.TypeOf(Cents(0))
+
+	generator := NewFakeGenerator()
+	err := generator.AddTypeProvider(centsType, func(ctx context.Context, v reflect.Value) (interface{}, error) {
+		return 1000 + RandFromContext(ctx).Intn(1000), nil
+	})
+	if err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+
+	var sample Sample
+	if err := generator.FakeData(context.Background(), &sample); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	values := append([]Cents{sample.Price, *sample.Optional}, sample.Prices...)
+	values = append(values, sample.Fixed[:]...)
+	for k, v := range sample.ByCents {
+		values = append(values, k, v)
+	}
+	for _, value := range values {
+		if value < 1000 || value >= 2000 {
+			t.Errorf("expected a value from the type provider but got %d", value)
+		}
+	}
+	if err := validateRange(int(sample.Tagged)); err != nil {
+		t.Error(err)
+	}
+
+	err = generator.AddTypeProvider(centsType, func(ctx context.Context, v reflect.Value) (interface{}, error) {
+		return nil, nil
+	})
+	if err == nil {
+		t.Error("expected error for an existing type provider, but got nil")
+	}
+
+	wrongType := NewFakeGenerator()
+	err = wrongType.AddTypeProvider(centsType, func(ctx context.Context, v reflect.Value) (interface{}, error) {
+		return "ten", nil
+	})
+	if err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if err := wrongType.FakeData(context.Background(), &sample); err == nil {
+		t.Error("expected error for a provider returning the wrong type, but got nil")
+	}
+}
+
+func TestStandardLibraryTypes(t *testing.T) {
+	type Sample struct {
+		Duration     time.Duration
+		Location     *time.Location
+		IP           net.IP
+		IPNet        net.IPNet
+		HardwareAddr net.HardwareAddr
+		URL          *url.URL
+		BigInt       *big.Int
+		BigFloat     big.Float
+		Raw          json.RawMessage
+		Regexp       *regexp.Regexp
+		NullString   sql.NullString
+		NullInt64    sql.NullInt64
+		NullInt32    sql.NullInt32
+		NullFloat64  sql.NullFloat64
+		NullBool     sql.NullBool
+		NullTime     sql.NullTime
+	}
+
+	for i := 0; i < 20; i++ {
+		var sample Sample
+		if err := NewFakeGenerator().FakeData(context.Background(), &sample); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		if sample.Duration < 0 || sample.Duration >= 24*time.Hour {
+			t.Errorf("unexpected duration %s", sample.Duration)
+		}
+		if sample.Location == nil || sample.Location.String() == "" {
+			t.Errorf("expected a location but got %v", sample.Location)
+		}
+		if len(sample.IP) != net.IPv4len && len(sample.IP) != net.IPv6len {
+			t.Errorf("unexpected IP %v", sample.IP)
+		}
+		if _, _, err := net.ParseCIDR(sample.IPNet.String()); err != nil {
+			t.Errorf("unexpected IP network %v: %s", sample.IPNet, err)
+		}
+		if len(sample.HardwareAddr) != 6 {
+			t.Errorf("unexpected hardware address %v", sample.HardwareAddr)
+		}
+		if sample.URL == nil || !strings.HasPrefix(sample.URL.Scheme, "http") || sample.URL.Host == "" {
+			t.Errorf("unexpected URL %v", sample.URL)
+		}
+		if sample.BigInt == nil {
+			t.Error("expected a big.Int but got nil")
+		}
+		if _, err := sample.BigFloat.MarshalText(); err != nil {
+			t.Errorf("unexpected big.Float: %s", err)
+		}
+		if !json.Valid(sample.Raw) {
+			t.Errorf("expected valid JSON but got %s", sample.Raw)
+		}
+		if sample.Regexp == nil || sample.Regexp.String() == "" {
+			t.Errorf("expected a regexp but got %v", sample.Regexp)
+		}
+		if !sample.NullString.Valid && sample.NullString.String != "" {
+			t.Errorf("expected an empty string for an invalid NullString but got %s", sample.NullString.String)
+		}
+		if !sample.NullTime.Valid && !sample.NullTime.Time.IsZero() {
+			t.Errorf("expected a zero time for an invalid NullTime but got %s", sample.NullTime.Time)
+		}
+		if !sample.NullInt64.Valid && sample.NullInt64.Int64 != 0 {
+			t.Errorf("expected zero for an invalid NullInt64 but got %d", sample.NullInt64.Int64)
+		}
+	}
+}
+
+func TestRegexTag(t *testing.T) {
+	type Sample struct {
+		SKU      string            `faker:"regex=^[A-Z]{3}-\\d{4}$"`
+		Postcode string            `faker:"regex=^\\d{5}(-\\d{4})?$"`
+		Range    string            `faker:"regex=[a-c]{2,4}x+"`
+		OrderIDs []string          `faker:"regex=ORD-[0-9a-f]{8}"`
+		Kept     string            `faker:"keep,regex=(?i)kept|zero"`
+		ByCode   map[string]string `faker:"regex=[A-Z]{2}"`
+	}
+	patterns := map[string]*regexp.Regexp{
+		"SKU":      regexp.MustCompile(`^[A-Z]{3}-\d{4}$`),
+		"Postcode": regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+		"Range":    regexp.MustCompile(`^[a-c]{2,4}x+$`),
+		"OrderID":  regexp.MustCompile(`^ORD-[0-9a-f]{8}$`),
+		"Kept":     regexp.MustCompile(`^(?i)kept|zero$`),
+		"Code":     regexp.MustCompile(`^[A-Z]{2}$`),
+	}
+
+	for i := 0; i < 20; i++ {
+		var sample Sample
+		if err := NewFakeGenerator().FakeData(context.Background(), &sample); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		values := map[string][]string{
+			"SKU":      {sample.SKU},
+			"Postcode": {sample.Postcode},
+			"Range":    {sample.Range},
+			"OrderID":  sample.OrderIDs,
+			"Kept":     {sample.Kept},
+		}
+		for k, v := range sample.ByCode {
+			values["Code"] = append(values["Code"], k, v)
+		}
+		for name, list := range values {
+			for _, value := range list {
+				if !patterns[name].MatchString(value) {
+					t.Errorf("%s: %q does not match %s", name, value, patterns[name])
+				}
+			}
+		}
+	}
+}
+
+func TestRandomStringMatching(t *testing.T) {
+	for _, pattern := range []string{`[^\s]+@\w+\.(com|org)`, `.{3}\.?`, `a*b+c?`, `\p{Greek}{2}`, `(?i)hello`} {
+		res, err := RandomStringMatching(pattern)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %s", pattern, err)
+			continue
+		}
+		if !regexp.MustCompile("^(?:" + pattern + ")$").MatchString(res) {
+			t.Errorf("%q does not match %s", res, pattern)
+		}
+	}
+	if _, err := RandomStringMatching(`[a-`); !errors.Is(err, ErrWrongFormattedTag) {
+		t.Error("expected ErrWrongFormattedTag for an invalid pattern, but got ", err)
+	}
+	if _, err := RandomStringMatching(`[^\x00-\x{10FFFF}]`); err == nil {
+		t.Error("expected error for a pattern matching nothing, but got nil")
+	}
+}
+
+func TestOneOfTag(t *testing.T) {
+	type Sample struct {
+		Status   string            `faker:"oneof=active|pending|closed"`
+		Weighted string            `faker:"oneof=active:70|closed:30"`
+		Amt      Amount            `faker:"oneof=-5|10|250"`
+		Rate     float64           `faker:"oneof=0.5|1.25"`
+		Small    uint8             `faker:"oneof=1|2:3"`
+		Tags     []string          `faker:"oneof=red|green"`
+		Levels   map[string]string `faker:"oneof=low|high"`
+	}
+
+	counts := map[string]int{}
+	for i := 0; i < 200; i++ {
+		var sample Sample
+		if err := NewFakeGenerator().FakeData(context.Background(), &sample); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		if sample.Status != "active" && sample.Status != "pending" && sample.Status != "closed" {
+			t.Errorf("unexpected Status %q", sample.Status)
+		}
+		if sample.Amt != -5 && sample.Amt != 10 && sample.Amt != 250 {
+			t.Errorf("unexpected Amt %d", sample.Amt)
+		}
+		if sample.Rate != 0.5 && sample.Rate != 1.25 {
+			t.Errorf("unexpected Rate %f", sample.Rate)
+		}
+		if sample.Small != 1 && sample.Small != 2 {
+			t.Errorf("unexpected Small %d", sample.Small)
+		}
+		for _, tag := range sample.Tags {
+			if tag != "red" && tag != "green" {
+				t.Errorf("unexpected Tag %q", tag)
+			}
+		}
+		for k, v := range sample.Levels {
+			if (k != "low" && k != "high") || (v != "low" && v != "high") {
+				t.Errorf("unexpected Level %q:%q", k, v)
+			}
+		}
+		counts[sample.Weighted]++
+	}
+	if len(counts) != 2 || counts["active"] <= counts["closed"] {
+		t.Errorf("expected mostly active values, but got %v", counts)
+	}
+}
+
+func TestOneOfTagErrors(t *testing.T) {
+	for name, sample := range map[string]interface{}{
+		"not a number": &struct {
+			Amt Amount `faker:"oneof=1|two"`
+		}{},
+		"out of range": &struct {
+			Small int8 `faker:"oneof=1|300"`
+		}{},
+		"negative unsigned": &struct {
+			Count uint `faker:"oneof=-1|1"`
+		}{},
+		"bad weight": &struct {
+			Status string `faker:"oneof=active:x|closed"`
+		}{},
+		"zero weight": &struct {
+			Status string `faker:"oneof=active:0|closed"`
+		}{},
+		"empty": &struct {
+			Status string `faker:"oneof="`
+		}{},
+	} {
+		if err := NewFakeGenerator().FakeData(context.Background(), sample); err == nil {
+			t.Errorf("%s: expected error, but got nil", name)
+		}
+	}
+}
+
+func TestUniqueTag(t *testing.T) {
+	type User struct {
+		Email    string `faker:"email,unique"`
+		Backup   string `faker:"email,unique"`
+		Level    int    `faker:"boundary_start=0, boundary_end=50, unique"`
+		UserName string `faker:"username"`
+		ID       string `faker:"uuid_digit"`
+	}
+
+	generator := NewFakeGenerator(WithSeed(7), WithUniqueTags(UserNameTag, ID))
+	if err := generator.SetUniqueRetries(1000); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	emails, usernames, ids, levels := map[string]bool{}, map[string]bool{}, map[string]bool{}, map[int]bool{}
+	for i := 0; i < 25; i++ {
+		var user User
+		if err := generator.FakeData(context.Background(), &user); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		if emails[user.Email] || emails[user.Backup] || user.Email == user.Backup {
+			t.Errorf("duplicated email %q or %q", user.Email, user.Backup)
+		}
+		if usernames[user.UserName] || ids[user.ID] || levels[user.Level] {
+			t.Errorf("duplicated value in %+v", user)
+		}
+		emails[user.Email], emails[user.Backup] = true, true
+		usernames[user.UserName], ids[user.ID], levels[user.Level] = true, true, true
+	}
+
+	var user User
+	if err := generator.FakeData(context.Background(), &user); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	generator.ResetUnique()
+	for i := 0; i < 50; i++ {
+		if err := generator.FakeData(context.Background(), &user); err != nil {
+			t.Fatal("Expected Not Error after reset, But Got: ", err)
+		}
+	}
+	if err := generator.FakeData(context.Background(), &user); err == nil {
+		t.Error("Expected error when unique values are exhausted, But Got nil")
+	}
+}
+
+func TestUniqueUntaggedField(t *testing.T) {
+	type Flag struct {
+		On bool `faker:"unique"`
+	}
+	generator := NewFakeGenerator()
+	if err := generator.SetUniqueRetries(1000); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	var a, b Flag
+	if err := generator.FakeData(context.Background(), &a); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if err := generator.FakeData(context.Background(), &b); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if a.On == b.On {
+		t.Error("Expected different values, But Got: ", a.On, b.On)
+	}
+	if err := generator.FakeData(context.Background(), &a); err == nil {
+		t.Error("Expected error when unique values are exhausted, But Got nil")
+	}
+
+	type List struct {
+		Items []string `faker:"unique"`
+	}
+	if err := NewFakeGenerator().FakeData(context.Background(), &List{}); err == nil {
+		t.Error("Expected error for a non comparable unique field, But Got nil")
+	}
+	if err := NewFakeGenerator().SetUniqueRetries(-1); err == nil {
+		t.Error("Expected error for negative retries, But Got nil")
+	}
+}
+
+func TestFakeMany(t *testing.T) {
+	type Row struct {
+		ID    int64
+		Email string `faker:"email"`
+	}
+
+	var rows []Row
+	err := NewFakeGenerator().FakeMany(context.Background(), &rows, 50, func(i int, elem interface{}) error {
+		elem.(*Row).ID = int64(i + 1)
+		return nil
+	})
+	if err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if len(rows) != 50 {
+		t.Fatal("Expected 50 rows, But Got: ", len(rows))
+	}
+	for i, row := range rows {
+		if row.ID != int64(i+1) || !strings.Contains(row.Email, "@") {
+			t.Errorf("unexpected row %d: %+v", i, row)
+		}
+	}
+
+	var pointers []*Row
+	if err := NewFakeGenerator().FakeMany(context.Background(), &pointers, 3); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	for _, row := range pointers {
+		if row == nil || row.Email == "" {
+			t.Errorf("expected a filled row, but got %+v", row)
+		}
+	}
+
+	a, b := []int{}, []int{}
+	if err := NewFakeGenerator(WithSeed(3)).FakeMany(context.Background(), &a, 20); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if err := NewFakeGenerator(WithSeed(3)).FakeMany(context.Background(), &b, 20); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("expected the same values for the same seed, but got %v and %v", a, b)
+	}
+}
+
+func TestFakeManyErrors(t *testing.T) {
+	generator := NewFakeGenerator()
+	var rows []string
+	if err := generator.FakeMany(context.Background(), rows, 1); !errors.Is(err, ErrNotSlicePtr) {
+		t.Error("Expected ErrNotSlicePtr, But Got: ", err)
+	}
+	if err := generator.FakeMany(context.Background(), &rows, -1); err == nil {
+		t.Error("Expected error for a negative count, But Got nil")
+	}
+	overrideErr := errors.New("override failed")
+	err := generator.FakeMany(context.Background(), &rows, 2, func(i int, elem interface{}) error {
+		return overrideErr
+	})
+	if err != overrideErr {
+		t.Error("Expected the override error, But Got: ", err)
+	}
+}
+
+func TestStructPlanInvalidation(t *testing.T) {
+	type Account struct {
+		Owner  string
+		Secret string
+	}
+	generator := NewFakeGenerator()
+	var account Account
+	if err := generator.FakeData(context.Background(), &account); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if account.Secret == "" {
+		t.Error("Expected Secret to be filled")
+	}
+
+	generator.AddFieldTag("Owner", "owner")
+	if err := generator.AddProvider("owner", func(ctx context.Context, v reflect.Value) (interface{}, error) {
+		return "root", nil
+	}); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	generator.AddFieldFilter("^Secret$")
+
+	account = Account{}
+	if err := generator.FakeData(context.Background(), &account); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if account.Owner != "root" {
+		t.Error("Expected Owner to use the new field tag, But Got: ", account.Owner)
+	}
+	if account.Secret != "" {
+		t.Error("Expected Secret to be filtered, But Got: ", account.Secret)
+	}
+}
+
+func TestConcurrentFakeData(t *testing.T) {
+	generator := NewFakeGenerator()
+	wg := sync.WaitGroup{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				var a SomeStruct
+				if err := generator.FakeData(context.Background(), &a); err != nil {
+					t.Error("Expected Not Error, But Got: ", err)
+				}
+			}
+			generator.AddFieldTag(fmt.Sprintf("Field%d", i), EmailTag)
+			SetNetwork(GetNetworker())
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestFakeManyParallel(t *testing.T) {
+	type Row struct {
+		Name  string `faker:"name"`
+		Email string `faker:"email"`
+		Count int
+		Child *CStruct
+	}
+
+	fake := func(workers int) []Row {
+		var rows []Row
+		err := NewFakeGenerator(WithSeed(11), WithReferenceTime(time.Unix(0, 0))).FakeManyParallel(context.Background(), &rows, 200, workers)
+		if err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		return rows
+	}
+	sequential, parallel := fake(1), fake(8)
+	if len(parallel) != 200 {
+		t.Fatal("Expected 200 rows, But Got: ", len(parallel))
+	}
+	if !reflect.DeepEqual(sequential, parallel) {
+		t.Error("Expected the same rows whatever the number of workers")
+	}
+
+	var rows []int
+	failure := errors.New("override failed")
+	err := NewFakeGenerator().FakeManyParallel(context.Background(), &rows, 10, 0, func(i int, elem interface{}) error {
+		if i == 5 {
+			return failure
+		}
+		return nil
+	})
+	if err != failure {
+		t.Error("Expected the override error, But Got: ", err)
+	}
+}
+
+type BaseEntity struct {
+	ID        string
+	CreatedAt string
+}
+
+type Customer struct {
+	Email string
+	Name  string
+}
+
+type Order struct {
+	BaseEntity
+	ID       string
+	Customer Customer
+	Billing  *Customer
+}
+
+type Invoice struct {
+	*BaseEntity
+	Order Order
+}
+
+func TestPathAwareFieldTags(t *testing.T) {
+	constant := func(value string) TaggedFunction {
+		return func(ctx context.Context, v reflect.Value) (interface{}, error) {
+			return value, nil
+		}
+	}
+	generator := NewFakeGenerator()
+	for tag, value := range map[string]string{"customer-email": "customer@example.com", "base-id": "base", "created": "today"} {
+		if err := generator.AddProvider(tag, constant(value)); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+	}
+	generator.AddFieldTag("Order.Customer.Email", "customer-email")
+	generator.AddFieldTag("BaseEntity.ID", "base-id")
+	generator.AddFieldTag("Order.CreatedAt", "created")
+	generator.AddFieldFilter(`Order\.Billing\.Name`)
+
+	var order Order
+	if err := generator.FakeData(context.Background(), &order); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if order.Customer.Email != "customer@example.com" || order.Billing.Email == "customer@example.com" {
+		t.Errorf("expected only Order.Customer.Email to be tagged, but got %q and %q", order.Customer.Email, order.Billing.Email)
+	}
+	if order.BaseEntity.ID != "base" || order.ID == "base" {
+		t.Errorf("expected only BaseEntity.ID to be tagged, but got %q and %q", order.BaseEntity.ID, order.ID)
+	}
+	if order.CreatedAt != "today" {
+		t.Error("Expected the promoted Order.CreatedAt to be tagged, But Got: ", order.CreatedAt)
+	}
+	if order.Billing.Name != "" || order.Customer.Name == "" {
+		t.Errorf("expected only Order.Billing.Name to be filtered, but got %q and %q", order.Billing.Name, order.Customer.Name)
+	}
+
+	generator = NewFakeGenerator()
+	if err := generator.AddProvider("stamp", constant("stamped")); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	generator.AddFieldTag("*.CreatedAt", "stamp")
+	var invoice Invoice
+	if err := generator.FakeData(context.Background(), &invoice); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if invoice.BaseEntity == nil || invoice.CreatedAt != "stamped" || invoice.Order.CreatedAt != "stamped" {
+		t.Errorf("expected every CreatedAt to be tagged, but got %+v", invoice)
+	}
+}
+
+func TestNilProbability(t *testing.T) {
+	type Profile struct {
+		Email    *string           `faker:"email,nullable=0.5"`
+		Always   []string          `faker:"nullable=1"`
+		Never    *int              `faker:"nullable=0"`
+		Labels   map[string]string `faker:"nullable=0.5"`
+		Nickname *string
+		Child    *CStruct
+	}
+
+	generator := NewFakeGenerator()
+	if err := generator.SetTypeNilProbability(reflect.TypeOf(&CStruct{}), 1); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	nilEmails, nilLabels := 0, 0
+	for i := 0; i < 200; i++ {
+		var profile Profile
+		if err := generator.FakeData(context.Background(), &profile); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		if profile.Email == nil {
+			nilEmails++
+		} else if !strings.Contains(*profile.Email, "@") {
+			t.Error("Expected an email, But Got: ", *profile.Email)
+		}
+		if profile.Labels == nil {
+			nilLabels++
+		}
+		if profile.Always != nil || profile.Never == nil || profile.Nickname == nil || profile.Child != nil {
+			t.Errorf("unexpected nil fields in %+v", profile)
+		}
+	}
+	if nilEmails < 50 || nilEmails > 150 || nilLabels < 50 || nilLabels > 150 {
+		t.Errorf("expected about half of the values to be nil, but got %d emails and %d labels", nilEmails, nilLabels)
+	}
+
+	if err := generator.SetNilProbability(1); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	var profile Profile
+	if err := generator.FakeData(context.Background(), &profile); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if profile.Nickname != nil || profile.Never == nil {
+		t.Errorf("expected the global probability to apply to untagged fields only, but got %+v", profile)
+	}
+}
+
+func TestNilProbabilityErrors(t *testing.T) {
+	generator := NewFakeGenerator()
+	if err := generator.SetNilProbability(1.5); err == nil {
+		t.Error("Expected error for a probability above 1, But Got nil")
+	}
+	if err := generator.SetTypeNilProbability(reflect.TypeOf(""), 0.5); err == nil {
+		t.Error("Expected error for a type that can not be nil, But Got nil")
+	}
+	for name, sample := range map[string]interface{}{
+		"not nullable": &struct {
+			Name string `faker:"nullable=0.5"`
+		}{},
+		"not a number": &struct {
+			Name *string `faker:"nullable=often"`
+		}{},
+		"out of range": &struct {
+			Name *string `faker:"nullable=-1"`
+		}{},
+	} {
+		if err := generator.FakeData(context.Background(), sample); err == nil {
+			t.Errorf("%s: expected error, but got nil", name)
+		}
+	}
+}
+
+func TestSizeAndLengthRanges(t *testing.T) {
+	type Sample struct {
+		Emails   []string       `faker:"email,size=1..3"`
+		Tags     []string       `faker:"len=8..16,size=2..5"`
+		Code     string         `faker:"len=4..6"`
+		Fixed    []int          `faker:"size=3"`
+		Scores   map[string]int `faker:"size=2..4"`
+		Contacts [2]string      `faker:"email"`
+		Untagged []int
+		Children map[int]string
+	}
+
+	generator := NewFakeGenerator()
+	if err := generator.SetRandomMapAndSliceSize(4); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if err := generator.SetMinRandomMapAndSliceSize(2); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	for i := 0; i < 50; i++ {
+		var sample Sample
+		if err := generator.FakeData(context.Background(), &sample); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		if len(sample.Emails) < 1 || len(sample.Emails) > 3 {
+			t.Error("Expected 1 to 3 emails, But Got: ", len(sample.Emails))
+		}
+		for _, email := range append(sample.Emails, sample.Contacts[:]...) {
+			if !strings.Contains(email, "@") {
+				t.Error("Expected an email, But Got: ", email)
+			}
+		}
+		if len(sample.Tags) < 2 || len(sample.Tags) > 5 {
+			t.Error("Expected 2 to 5 tags, But Got: ", len(sample.Tags))
+		}
+		for _, tag := range sample.Tags {
+			if len(tag) < 8 || len(tag) > 16 {
+				t.Error("Expected a tag of 8 to 16 letters, But Got: ", tag)
+			}
+		}
+		if len(sample.Code) < 4 || len(sample.Code) > 6 {
+			t.Error("Expected a code of 4 to 6 letters, But Got: ", sample.Code)
+		}
+		if len(sample.Fixed) != 3 {
+			t.Error("Expected 3 items, But Got: ", len(sample.Fixed))
+		}
+		if len(sample.Scores) < 2 || len(sample.Scores) > 4 {
+			t.Error("Expected 2 to 4 scores, But Got: ", len(sample.Scores))
+		}
+		if len(sample.Untagged) < 2 || len(sample.Untagged) > 3 || len(sample.Children) < 2 || len(sample.Children) > 3 {
+			t.Errorf("expected 2 or 3 items, but got %v and %v", sample.Untagged, sample.Children)
+		}
+	}
+
+	if err := generator.SetMinRandomMapAndSliceSize(4); err == nil {
+		t.Error("Expected error for a minimum size not smaller than the size, But Got nil")
+	}
+	for name, sample := range map[string]interface{}{
+		"reversed size": &struct {
+			Items []int `faker:"size=5..2"`
+		}{},
+		"not a number": &struct {
+			Items []int `faker:"size=many"`
+		}{},
+		"negative length": &struct {
+			Name string `faker:"len=-1"`
+		}{},
+	} {
+		if err := NewFakeGenerator().FakeData(context.Background(), sample); err == nil {
+			t.Errorf("%s: expected error, but got nil", name)
+		}
+	}
+}
+
+func TestFloatBoundaries(t *testing.T) {
+	type Measurement struct {
+		Amount   float64   `faker:"boundary_start=0.5, boundary_end=99.99, precision=2"`
+		Ratio    float32   `faker:"boundary_start=-1.5, boundary_end=1.5"`
+		Rounded  float64   `faker:"precision=0"`
+		Readings []float64 `faker:"boundary_start=10, boundary_end=20, precision=1"`
+		Default  float64
+	}
+
+	generator := NewFakeGenerator()
+	if err := generator.SetRandomFloatBoundaries(100, 200); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	for i := 0; i < 100; i++ {
+		var m Measurement
+		if err := generator.FakeData(context.Background(), &m); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		if m.Amount < 0.5 || m.Amount > 99.99 || math.Abs(m.Amount*100-math.Round(m.Amount*100)) > 1e-6 {
+			t.Error("Expected an amount with 2 decimals between 0.5 and 99.99, But Got: ", m.Amount)
+		}
+		if m.Ratio < -1.5 || m.Ratio >= 1.5 {
+			t.Error("Expected a ratio between -1.5 and 1.5, But Got: ", m.Ratio)
+		}
+		if m.Rounded != math.Round(m.Rounded) || m.Rounded < 100 || m.Rounded > 200 {
+			t.Error("Expected a whole number between 100 and 200, But Got: ", m.Rounded)
+		}
+		for _, r := range m.Readings {
+			if r < 10 || r > 20 || math.Abs(r*10-math.Round(r*10)) > 1e-6 {
+				t.Error("Expected a reading with 1 decimal between 10 and 20, But Got: ", r)
+			}
+		}
+		if m.Default < 100 || m.Default >= 200 {
+			t.Error("Expected a value between 100 and 200, But Got: ", m.Default)
+		}
+	}
+
+	if err := generator.SetRandomFloatBoundaries(2, 1); err == nil {
+		t.Error("Expected error for reversed boundaries, But Got nil")
+	}
+	for name, sample := range map[string]interface{}{
+		"reversed": &struct {
+			F float64 `faker:"boundary_start=2.5, boundary_end=1.5"`
+		}{},
+		"negative precision": &struct {
+			F float64 `faker:"precision=-1"`
+		}{},
+		"missing end": &struct {
+			F float64 `faker:"boundary_start=2.5"`
+		}{},
+		"not a number": &struct {
+			F float32 `faker:"boundary_start=a, boundary_end=b"`
+		}{},
+	} {
+		if err := NewFakeGenerator().FakeData(context.Background(), sample); err == nil {
+			t.Errorf("%s: expected error, but got nil", name)
+		}
+	}
+}
+
+func TestFullRangeIntegers(t *testing.T) {
+	type Numbers struct {
+		Huge      uint64 `faker:"boundary=18446744073709551600..18446744073709551615"`
+		Negative  int64  `faker:"boundary=-9223372036854775808..-9223372036854775800"`
+		Signed    int8   `faker:"boundary=-128..127"`
+		Span      int    `faker:"boundary_start=-5, boundary_end=5"`
+		Same      int32  `faker:"boundary_start=7, boundary_end=7"`
+		Inclusive uint8  `faker:"boundary=0..1"`
+		Wide      int64
+		Unsigned  uint64
+	}
+
+	generator := NewFakeGenerator()
+	generator.SetFullNumberRange(true)
+	seenOne, seenBig := false, false
+	for i := 0; i < 200; i++ {
+		var n Numbers
+		if err := generator.FakeData(context.Background(), &n); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		if n.Huge < 18446744073709551600 || n.Negative > -9223372036854775800 {
+			t.Errorf("unexpected values near the limits %d and %d", n.Huge, n.Negative)
+		}
+		if n.Span < -5 || n.Span >= 5 || n.Same != 7 || n.Inclusive > 1 {
+			t.Errorf("unexpected bounded values in %+v", n)
+		}
+		seenOne = seenOne || n.Inclusive == 1
+		seenBig = seenBig || n.Wide > math.MaxInt32 || n.Wide < math.MinInt32 || n.Unsigned > math.MaxInt64
+	}
+	if !seenOne || !seenBig {
+		t.Errorf("expected inclusive bounds and full range values, but got %v and %v", seenOne, seenBig)
+	}
+
+	for name, sample := range map[string]interface{}{
+		"out of range": &struct {
+			N int8 `faker:"boundary=0..200"`
+		}{},
+		"negative unsigned": &struct {
+			N uint `faker:"boundary_start=-1, boundary_end=5"`
+		}{},
+		"reversed": &struct {
+			N int `faker:"boundary=5..1"`
+		}{},
+	} {
+		if err := NewFakeGenerator().FakeData(context.Background(), sample); err == nil {
+			t.Errorf("%s: expected error, but got nil", name)
+		}
+	}
+
+	var small struct {
+		ID     int
+		Data   []byte
+		Level  int8
+		Count  uint
+		Offset int16
+	}
+	generator = NewFakeGenerator()
+	if err := generator.SetRandomNumberBoundaries(0, 1000); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	for i := 0; i < 50; i++ {
+		if err := generator.FakeData(context.Background(), &small); err != nil {
+			t.Fatal("Expected the boundaries to be clamped to the range of each kind, But Got: ", err)
+		}
+		if small.ID < 0 || small.ID >= 1000 || small.Level < 0 || small.Count >= 1000 {
+			t.Errorf("unexpected values for boundaries [0, 1000) in %+v", small)
+		}
+	}
+	if err := generator.SetRandomNumberBoundaries(-10, 0); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if err := generator.FakeData(context.Background(), &small); err != nil {
+		t.Fatal("Expected the boundaries to be clamped to the range of uint, But Got: ", err)
+	}
+	if small.Count != 0 || small.Offset < -10 || small.Offset >= 0 {
+		t.Errorf("unexpected values for boundaries [-10, 0) in %+v", small)
+	}
+}
+
+func TestEdgeValues(t *testing.T) {
+	type Numbers struct {
+		I8     int8
+		U16    uint16
+		Ranged int `faker:"boundary=-3..3"`
+		F      float64
+	}
+
+	generator := NewFakeGenerator()
+	generator.SetFullNumberRange(true)
+	if err := generator.SetEdgeValueProbability(1); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	for i := 0; i < 50; i++ {
+		var n Numbers
+		if err := generator.FakeData(context.Background(), &n); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		if n.I8 != 0 && n.I8 != -1 && n.I8 != math.MinInt8 && n.I8 != math.MaxInt8 {
+			t.Error("Expected an edge int8, But Got: ", n.I8)
+		}
+		if n.U16 != 0 && n.U16 != 1 && n.U16 != math.MaxUint16 {
+			t.Error("Expected an edge uint16, But Got: ", n.U16)
+		}
+		if n.Ranged != 0 && n.Ranged != -1 && n.Ranged != -3 && n.Ranged != 3 {
+			t.Error("Expected an edge of the boundary, But Got: ", n.Ranged)
+		}
+		if n.F != 0 && n.F != math.Nextafter(1, 0) {
+			t.Error("Expected an edge float, But Got: ", n.F)
+		}
+	}
+
+	generator = NewFakeGenerator(WithSeed(1))
+	if err := generator.SetEdgeValueProbability(1); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	seen := make(map[int8]bool)
+	for i := 0; i < 50; i++ {
+		var n Numbers
+		if err := generator.FakeData(context.Background(), &n); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		seen[n.I8] = true
+	}
+	if len(seen) != 4 || !seen[0] || !seen[-1] || !seen[math.MinInt8] || !seen[math.MaxInt8] {
+		t.Error("Expected the edges of int8 with the default boundaries, But Got: ", seen)
+	}
+	if err := generator.SetEdgeValueProbability(2); err == nil {
+		t.Error("Expected error for a probability above 1, But Got nil")
+	}
+}
+
+func TestFieldError(t *testing.T) {
+	type Contact struct {
+		Email string `faker:"no_such_tag"`
+	}
+	type Account struct {
+		Contact Contact
+		Score   int `faker:"boundary=a..b"`
+	}
+	type Ledger struct {
+		Balance int `faker:"boundary=1..2"`
+		Owner   Account
+	}
+	generator := NewFakeGenerator()
+
+	var account Account
+	err := generator.FakeData(context.Background(), &account)
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatal("Expected a *FieldError, But Got: ", err)
+	}
+	if fieldErr.Path != "Contact.Email" || fieldErr.Tag != "no_such_tag" || fieldErr.Struct != reflect.TypeOf(Contact{}) {
+		t.Errorf("Expected the error of Contact.Email, But Got: %+v", fieldErr)
+	}
+	if !errors.Is(err, ErrTagNotSupported) {
+		t.Error("Expected ErrTagNotSupported, But Got: ", err)
+	}
+
+	var ledger Ledger
+	err = generator.FakeData(context.Background(), &ledger)
+	if !errors.As(err, &fieldErr) || fieldErr.Path != "Owner.Contact.Email" {
+		t.Error("Expected the error of Owner.Contact.Email, But Got: ", err)
+	}
+
+	generator.AddFieldFilter("Contact")
+	err = generator.FakeData(context.Background(), &account)
+	if !errors.As(err, &fieldErr) || fieldErr.Path != "Score" || !errors.Is(err, ErrWrongFormattedTag) {
+		t.Error("Expected ErrWrongFormattedTag for Score, But Got: ", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	type Tree struct {
+		Name     string  `faker:"len=5"`
+		Children []*Tree `faker:"size=0..2"`
+	}
+	type Profile struct {
+		Email   string  `faker:"emial"`
+		Country string  `faker:"oneof=NZ|AU"`
+		Code    string  `faker:"unique,regex=[A-Z]{3}"`
+		Age     int     `faker:"len=5"`
+		Score   uint8   `faker:"boundary=0..300"`
+		Rating  float64 `faker:"boundary_start=1, boundary_end=5, precision=2"`
+		Level   int     `faker:"boundry_start=1, boundary_end=5"`
+		Nick    string  `faker:"email,len=5"`
+	}
+	type Account struct {
+		Owner    Tree
+		Profiles map[string]Profile
+		Note     string   `faker:"size=2"`
+		Ref      *string  `faker:"nullable=0.5"`
+		ID       int      `faker:"nullable=0.5"`
+		Tags     []string `faker:"word,unique"`
+		Skipped  string   `faker:"-"`
+	}
+	generator := NewFakeGenerator()
+	if err := generator.Validate(reflect.TypeOf(&Tree{})); err != nil {
+		t.Error("Expected Not Error, But Got: ", err)
+	}
+
+	err := generator.Validate(reflect.TypeOf(Account{}))
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatal("Expected a *ValidationError, But Got: ", err)
+	}
+	expected := map[string]error{
+		"Profiles.Email": ErrTagNotSupported,
+		"Profiles.Age":   ErrTagNotSupported,
+		"Profiles.Score": ErrBoundaryOutOfRange,
+		"Profiles.Level": ErrTagNotSupported,
+		"Profiles.Nick":  ErrWrongFormattedTag,
+		"Note":           ErrNotSupportedTypeForTag,
+		"ID":             ErrNotNullable,
+	}
+	if len(validationErr.Fields) != len(expected) {
+		t.Errorf("Expected %d invalid fields, But Got: %v", len(expected), err)
+	}
+	for _, fieldErr := range validationErr.Fields {
+		if want, ok := expected[fieldErr.Path]; !ok || !errors.Is(fieldErr, want) {
+			t.Errorf("Expected %v for %s, But Got: %v", want, fieldErr.Path, fieldErr.Err)
+		}
+	}
+
+	generator.AddFieldTag("Account.Owner.Name", "first_nme")
+	err = generator.Validate(reflect.TypeOf(Tree{}))
+	if err != nil {
+		t.Error("Expected Not Error, But Got: ", err)
+	}
+	err = generator.Validate(reflect.TypeOf(Account{}))
+	if !errors.As(err, &validationErr) || validationErr.Fields[0].Path != "Owner.Name" {
+		t.Error("Expected an error for Owner.Name, But Got: ", err)
+	}
+}
+
+func TestDerivedFields(t *testing.T) {
+	type LineItem struct {
+		Price int `faker:"boundary=1..100"`
+	}
+	type Booking struct {
+		Email     string `faker:"derive=email_from(FirstName, LastName)"`
+		FirstName string `faker:"first_name"`
+		LastName  string `faker:"last_name"`
+		StartDate time.Time
+		EndDate   *time.Time `faker:"derive=after(StartDate)"`
+		Items     []LineItem `faker:"size=1..5"`
+		Total     int64      `faker:"derive=sum(Items.Price)"`
+		Total2    int64      `faker:"derive=sum(Total,Total)"`
+		Initials  string     `faker:"derive=initials(FirstName,LastName)"`
+	}
+	generator := NewFakeGenerator(WithSeed(7))
+	err := generator.AddDeriver("initials", func(ctx context.Context, v reflect.Value, args []interface{}) (interface{}, error) {
+		return args[0].(string)[:1] + args[1].(string)[:1], nil
+	})
+	if err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if err := generator.AddDeriver("initials", nil); !errors.Is(err, ErrDeriverExists) {
+		t.Error("Expected ErrDeriverExists, But Got: ", err)
+	}
+
+	for i := 0; i < 20; i++ {
+		var booking Booking
+		if err := generator.FakeData(context.Background(), &booking); err != nil {
+			t.Fatal("Expected Not Error, But Got: ", err)
+		}
+		local := strings.ToLower(booking.FirstName + "." + booking.LastName)
+		if !strings.HasPrefix(booking.Email, local+"@") {
+			t.Errorf("Expected the email of %s %s, But Got: %s", booking.FirstName, booking.LastName, booking.Email)
+		}
+		if booking.EndDate == nil || !booking.EndDate.After(booking.StartDate) {
+			t.Errorf("Expected an end date after %v, But Got: %v", booking.StartDate, booking.EndDate)
+		}
+		total := int64(0)
+		for _, item := range booking.Items {
+			total += int64(item.Price)
+		}
+		if booking.Total != total || booking.Total2 != 2*total {
+			t.Errorf("Expected totals %d and %d, But Got: %d and %d", total, 2*total, booking.Total, booking.Total2)
+		}
+		if booking.Initials != booking.FirstName[:1]+booking.LastName[:1] {
+			t.Error("Expected the initials of the names, But Got: ", booking.Initials)
+		}
+	}
+}
+
+func TestDerivedSumOfLargeIntegers(t *testing.T) {
+	type Ledger struct {
+		Signed   []int64  `faker:"keep"`
+		Unsigned []uint64 `faker:"keep"`
+		Large    []uint64 `faker:"keep"`
+		Fraction float64  `faker:"keep"`
+		Total    int64    `faker:"derive=sum(Signed)"`
+		UTotal   uint64   `faker:"derive=sum(Unsigned)"`
+		Mixed    uint64   `faker:"derive=sum(Signed,Large)"`
+		FTotal   float64  `faker:"derive=sum(Unsigned,Fraction)"`
+	}
+	ledger := Ledger{
+		Signed:   []int64{1<<60 + 1, 1<<60 + 2, -1},
+		Unsigned: []uint64{math.MaxUint64 - 2, 1},
+		Large:    []uint64{1 << 63},
+		Fraction: 0.5,
+	}
+	if err := NewFakeGenerator(WithSeed(7)).FakeData(context.Background(), &ledger); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if ledger.Total != 1<<61+2 {
+		t.Errorf("Expected %d, But Got: %d", int64(1<<61+2), ledger.Total)
+	}
+	if ledger.UTotal != math.MaxUint64-1 {
+		t.Errorf("Expected %d, But Got: %d", uint64(math.MaxUint64-1), ledger.UTotal)
+	}
+	if want := uint64(1<<63 + 1<<61 + 2); ledger.Mixed != want {
+		t.Errorf("Expected %d, But Got: %d", want, ledger.Mixed)
+	}
+	if want := float64(math.MaxUint64-1) + 0.5; ledger.FTotal != want {
+		t.Errorf("Expected %f, But Got: %f", want, ledger.FTotal)
+	}
+}
+
+func TestDerivedFieldErrors(t *testing.T) {
+	type Cycle struct {
+		A int `faker:"derive=sum(B)"`
+		B int `faker:"derive=sum(A)"`
+	}
+	type Unknown struct {
+		A int `faker:"derive=sum(Missing)"`
+	}
+	type NoDeriver struct {
+		A int `faker:"derive=product(B)"`
+		B int
+	}
+	type Malformed struct {
+		A int `faker:"derive=sum(B"`
+		B int
+	}
+	generator := NewFakeGenerator()
+	cases := []struct {
+		value    interface{}
+		expected error
+	}{
+		{&Cycle{}, ErrDerivationCycle},
+		{&Unknown{}, ErrUnknownField},
+		{&NoDeriver{}, ErrUnknownDeriver},
+		{&Malformed{}, ErrWrongFormattedTag},
+	}
+	for _, c := range cases {
+		if err := generator.FakeData(context.Background(), c.value); !errors.Is(err, c.expected) {
+			t.Errorf("Expected %v for %T, But Got: %v", c.expected, c.value, err)
+		}
+		if err := generator.Validate(reflect.TypeOf(c.value)); !errors.Is(err.(*ValidationError).Fields[0], c.expected) {
+			t.Errorf("Expected %v from Validate for %T, But Got: %v", c.expected, c.value, err)
+		}
+	}
+}
+
+type ChecksumLine struct {
+	Amount int `faker:"boundary=1..100"`
+}
+
+type ChecksumInvoice struct {
+	Lines    []ChecksumLine `faker:"size=1..5"`
+	Total    int
+	Checksum string
+}
+
+func (i *ChecksumInvoice) AfterFake(ctx context.Context) error {
+	i.Total = 0
+	for _, line := range i.Lines {
+		i.Total += line.Amount
+	}
+	i.Checksum = fmt.Sprintf("%x", i.Total)
+	return nil
+}
+
+type ChecksumLedger struct {
+	Invoices []ChecksumInvoice `faker:"size=2"`
+	Main     *ChecksumInvoice
+}
+
+func TestAfterFake(t *testing.T) {
+	generator := NewFakeGenerator()
+	var ledger ChecksumLedger
+	if err := generator.FakeData(context.Background(), &ledger); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	for _, invoice := range append(ledger.Invoices, *ledger.Main) {
+		total := 0
+		for _, line := range invoice.Lines {
+			total += line.Amount
+		}
+		if invoice.Total != total || invoice.Checksum != fmt.Sprintf("%x", total) {
+			t.Errorf("Expected total %d, But Got: %+v", total, invoice)
+		}
+	}
+}
+
+func TestFieldHooks(t *testing.T) {
+	type Account struct {
+		ID    string
+		Name  string
+		Email string `faker:"email"`
+	}
+	generator := NewFakeGenerator()
+	generator.AddBeforeFieldHook(func(ctx context.Context, parent reflect.Value, field reflect.StructField) error {
+		if field.Name == "ID" {
+			parent.FieldByName("ID").SetString("fixed")
+			return ErrSkipField
+		}
+		return nil
+	})
+	paths := make([]string, 0)
+	generator.AddAfterValueHook(func(ctx context.Context, v reflect.Value) error {
+		paths = append(paths, FieldPathFromContext(ctx))
+		if v.Kind() == reflect.String {
+			v.SetString(strings.ToUpper(v.String()))
+		}
+		return nil
+	})
+
+	var account Account
+	if err := generator.FakeData(context.Background(), &account); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if account.ID != "FIXED" || account.Email != strings.ToUpper(account.Email) {
+		t.Errorf("Expected the hooks to set the fields, But Got: %+v", account)
+	}
+	if strings.Join(paths, ",") != "ID,Name,Email," {
+		t.Error("Expected the hooks to be called for every field and the value, But Got: ", paths)
+	}
+
+	hookErr := errors.New("hook failed")
+	generator.AddAfterValueHook(func(ctx context.Context, v reflect.Value) error {
+		return hookErr
+	})
+	if err := generator.FakeData(context.Background(), &account); !errors.Is(err, hookErr) {
+		t.Error("Expected the hook error, But Got: ", err)
+	}
+}
+
+type FakeableMoney struct {
+	Currency string
+	Cents    int64
+}
+
+func (m *FakeableMoney) Fake(ctx context.Context, g *FakeGenerator) error {
+	m.Currency = "EUR"
+	m.Cents = RandFromContext(ctx).Int63n(100000)
+	return nil
+}
+
+type FakeableGeoPoint struct {
+	Lat   float64
+	Lng   float64
+	Label string `faker:"word"`
+}
+
+func (p *FakeableGeoPoint) Fake(ctx context.Context, g *FakeGenerator) error {
+	if err := g.FakeData(ctx, p); err != nil {
+		return err
+	}
+	p.Lat, p.Lng = p.Lat*180-90, p.Lng*360-180
+	return nil
+}
+
+func TestFakeable(t *testing.T) {
+	type Wallet struct {
+		Balance  FakeableMoney
+		Limit    *FakeableMoney
+		History  []FakeableMoney `faker:"size=3"`
+		Location FakeableGeoPoint
+	}
+	generator := NewFakeGenerator()
+	var wallet Wallet
+	if err := generator.FakeData(context.Background(), &wallet); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	for _, money := range append(wallet.History, wallet.Balance, *wallet.Limit) {
+		if money.Currency != "EUR" || money.Cents < 0 || money.Cents >= 100000 {
+			t.Error("Expected money generated by Fake, But Got: ", money)
+		}
+	}
+	location := wallet.Location
+	if location.Lat < -90 || location.Lat > 90 || location.Lng < -180 || location.Lng > 180 || location.Label == "" {
+		t.Error("Expected a point generated by Fake through the generator, But Got: ", location)
+	}
+
+	generator.AddTypeProvider(reflect.TypeOf(FakeableMoney{}), func(ctx context.Context, v reflect.Value) (interface{}, error) {
+		return FakeableMoney{Currency: "USD"}, nil
+	})
+	if err := generator.FakeData(context.Background(), &wallet); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	if wallet.Balance.Currency != "USD" {
+		t.Error("Expected the type provider to take precedence, But Got: ", wallet.Balance)
+	}
+}
+
+type UnmarshalCountryCode string
+
+func (c *UnmarshalCountryCode) UnmarshalText(text []byte) error {
+	if len(text) < 2 {
+		return fmt.Errorf("invalid country code %q", text)
+	}
+	*c = UnmarshalCountryCode(strings.ToUpper(string(text[:2])))
+	return nil
+}
+
+type UnmarshalCents int64
+
+func (c *UnmarshalCents) UnmarshalJSON(data []byte) error {
+	var n int64
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+	*c = UnmarshalCents(n)
+	return nil
+}
+
+type UnmarshalLabel string
+
+func (l *UnmarshalLabel) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*l = UnmarshalLabel("label:" + s)
+	return nil
+}
+
+type UnmarshalStatus struct {
+	Code string
+}
+
+func (s *UnmarshalStatus) Scan(src interface{}) error {
+	s.Code = "status:" + src.(string)
+	return nil
+}
+
+func TestUnmarshalProviderStrings(t *testing.T) {
+	type Shipment struct {
+		Country UnmarshalCountryCode   `faker:"country"`
+		Via     *UnmarshalCountryCode  `faker:"country"`
+		Stops   []UnmarshalCountryCode `faker:"country,size=2"`
+		Code    UnmarshalCountryCode   `faker:"oneof=nz|au"`
+		Price   UnmarshalCents         `faker:"price"`
+		Status  UnmarshalStatus        `faker:"status"`
+		Label   UnmarshalLabel         `faker:"status"`
+		Number  UnmarshalLabel         `faker:"oneof=1234|5678"`
+		Flag    UnmarshalLabel         `faker:"flag"`
+		Digits  UnmarshalLabel         `faker:"regex=^\\d{4}$"`
+		Address net.IP                 `faker:"ipv4"`
+	}
+	generator := NewFakeGenerator()
+	generator.AddProvider("country", func(ctx context.Context, v reflect.Value) (interface{}, error) {
+		return randomElementFromSliceString(RandFromContext(ctx), []string{"nz", "au", "de"}), nil
+	})
+	generator.AddProvider("price", func(ctx context.Context, v reflect.Value) (interface{}, error) {
+		return "1234", nil
+	})
+	generator.AddProvider("status", func(ctx context.Context, v reflect.Value) (interface{}, error) {
+		return "open", nil
+	})
+	generator.AddProvider("flag", func(ctx context.Context, v reflect.Value) (interface{}, error) {
+		return "true", nil
+	})
+	generator.AddProvider("short", func(ctx context.Context, v reflect.Value) (interface{}, error) {
+		return "x", nil
+	})
+
+	var shipment Shipment
+	if err := generator.FakeData(context.Background(), &shipment); err != nil {
+		t.Fatal("Expected Not Error, But Got: ", err)
+	}
+	codes := append([]UnmarshalCountryCode{shipment.Country, *shipment.Via, shipment.Code}, shipment.Stops...)
+	for _, code := range codes {
+		if code != "NZ" && code != "AU" && code != "DE" {
+			t.Error("Expected a country code parsed by UnmarshalText, But Got: ", code)
+		}
+	}
+	if shipment.Price != 1234 || shipment.Status.Code != "status:open" || shipment.Label != "label:open" ||
+		(shipment.Number != "label:1234" && shipment.Number != "label:5678") || shipment.Flag != "label:true" ||
+		len(shipment.Digits) != len("label:1234") || shipment.Address.To4() == nil {
+		t.Errorf("Expected values parsed by their types, But Got: %+v", shipment)
+	}
+
+	type Broken struct {
+		Country UnmarshalCountryCode `faker:"short"`
+	}
+	var broken Broken
+	if err := generator.FakeData(context.Background(), &broken); err == nil {
+		t.Error("Expected the UnmarshalText error, But Got nil")
+	}
+}
+
+func TestFieldErrorTagsAndSentinels(t *testing.T) {
+	type Account struct {
+		Name  string `faker:"unique"`
+		Email []int  `faker:"email,size=1"`
+	}
+	generator := NewFakeGenerator()
+	generator.AddFieldTag("Account.Name", "no_such_tag")
+
+	var account Account
+	err := generator.FakeData(context.Background(), &account)
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Path != "Name" || fieldErr.Tag != "unique,no_such_tag" {
+		t.Error("Expected the tag added with AddFieldTag in the error, But Got: ", err)
+	}
+
+	generator = NewFakeGenerator()
+	err = generator.FakeData(context.Background(), &account)
+	if !errors.Is(err, ErrWrongProviderType) || !errors.As(err, &fieldErr) || fieldErr.Tag != "email,size=1" {
+		t.Error("Expected ErrWrongProviderType for Email, But Got: ", err)
+	}
+
+	var empty interface{}
+	if _, err := generator.getValue(context.Background(), empty); !errors.Is(err, ErrUnknownType) {
+		t.Error("Expected ErrUnknownType, But Got: ", err)
+	}
+}
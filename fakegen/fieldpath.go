@@ -0,0 +1,163 @@
+package fakegen
+
+import (
+	"context"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const pathSeparator = "."
+
+type rootTypeKey struct{}
+
+type embeddingKey struct{}
+
+// embedding describes an embedded struct being generated: the nearest enclosing struct that is not
+// embedded itself, its field path, and the index path leading from it to the embedded struct. It is
+// used to match promoted fields by the name Go resolves them with.
+type embedding struct {
+	outer reflect.Type
+	path  string
+	index []int
+}
+
+// withRootType returns a copy of ctx recording t as the type of the top level struct, unless one
+// is already recorded.
+func withRootType(ctx context.Context, t reflect.Type) context.Context {
+	if rootTypeFromContext(ctx) != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, rootTypeKey{}, t)
+}
+
+func rootTypeFromContext(ctx context.Context) reflect.Type {
+	t, _ := ctx.Value(rootTypeKey{}).(reflect.Type)
+	return t
+}
+
+// withEmbedding returns a copy of ctx recording the embedding of the struct about to be generated,
+// or clearing it when e is nil.
+func withEmbedding(ctx context.Context, e *embedding) context.Context {
+	return context.WithValue(ctx, embeddingKey{}, e)
+}
+
+func embeddingFromContext(ctx context.Context) *embedding {
+	e, _ := ctx.Value(embeddingKey{}).(*embedding)
+	return e
+}
+
+// childEmbedding returns the embedding of field i of the struct t generated at path, or nil when
+// the field is not an embedded struct or pointer to struct.
+func childEmbedding(ctx context.Context, t reflect.Type, i int, path string) *embedding {
+	field := t.Field(i)
+	ft := field.Type
+	if ft.Kind() == reflect.Ptr {
+		ft = ft.Elem()
+	}
+	if !field.Anonymous || ft.Kind() != reflect.Struct {
+		return nil
+	}
+	if e := embeddingFromContext(ctx); e != nil {
+		index := append(append([]int{}, e.index...), i)
+		return &embedding{outer: e.outer, path: e.path, index: index}
+	}
+	return &embedding{outer: t, path: path, index: []int{i}}
+}
+
+// fieldNames returns the names field i of the struct t, generated at path, can be matched by, most
+// specific first. For a field Email of Customer, reached as Order.Customer.Email, these are
+// "Order.Customer.Email", "Customer.Email", "Customer.Email" (the owner type and the field) and
+// "Email". Fields promoted from embedded structs can also be matched through the struct they are
+// promoted to, e.g. "Order.CreatedAt" for the CreatedAt field of an embedded Base.
+func fieldNames(ctx context.Context, t reflect.Type, i int, path string) []string {
+	name := t.Field(i).Name
+	fieldPath := joinPath(path, name)
+	root := rootTypeFromContext(ctx)
+
+	names := make([]string, 0, 7)
+	if root != nil && root.Name() != "" {
+		names = append(names, joinPath(root.Name(), fieldPath))
+	}
+	names = append(names, fieldPath)
+
+	if e := embeddingFromContext(ctx); e != nil && isPromoted(e, i, name) {
+		promotedPath := joinPath(e.path, name)
+		if root != nil && root.Name() != "" {
+			names = append(names, joinPath(root.Name(), promotedPath))
+		}
+		names = append(names, promotedPath)
+		if e.outer.Name() != "" {
+			names = append(names, joinPath(e.outer.Name(), name))
+		}
+	}
+	if t.Name() != "" {
+		names = append(names, joinPath(t.Name(), name))
+	}
+	names = append(names, name)
+
+	unique := names[:0]
+	seen := make(map[string]bool, len(names))
+	for _, n := range names {
+		if !seen[n] {
+			seen[n] = true
+			unique = append(unique, n)
+		}
+	}
+	return unique
+}
+
+// isPromoted reports whether field i of the embedded struct described by e is reachable as name
+// from the outer struct, i.e. it is neither shadowed nor ambiguous.
+func isPromoted(e *embedding, i int, name string) bool {
+	field, ok := e.outer.FieldByName(name)
+	if !ok || len(field.Index) != len(e.index)+1 {
+		return false
+	}
+	for j, index := range e.index {
+		if field.Index[j] != index {
+			return false
+		}
+	}
+	return field.Index[len(e.index)] == i
+}
+
+func joinPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + pathSeparator + name
+}
+
+// fieldTag returns the tag added with AddFieldTag for the first of names it matches, trying exact
+// names before wildcard patterns such as "*.CreatedAt", where * stands for one path element.
+func (f *FakeGenerator) fieldTag(names []string) (string, bool) {
+	for _, name := range names {
+		if tag, ok := f.fieldTags[name]; ok {
+			return tag, true
+		}
+	}
+
+	patterns := make([]string, 0)
+	for pattern := range f.fieldTags {
+		if strings.Contains(pattern, "*") {
+			patterns = append(patterns, pattern)
+		}
+	}
+	sort.Strings(patterns)
+	for _, name := range names {
+		for _, pattern := range patterns {
+			if matchPath(pattern, name) {
+				return f.fieldTags[pattern], true
+			}
+		}
+	}
+	return "", false
+}
+
+// matchPath matches a dot separated name against a pattern whose * match a single path element.
+func matchPath(pattern, name string) bool {
+	ok, err := path.Match(strings.Replace(pattern, pathSeparator, "/", -1), strings.Replace(name, pathSeparator, "/", -1))
+	return err == nil && ok
+}
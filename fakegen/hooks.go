@@ -0,0 +1,77 @@
+package fakegen
+
+import (
+	"context"
+	"errors"
+	"reflect"
+)
+
+// AfterFaker is implemented by types that fix up their own invariants, such as checksums or computed
+// totals, once generated. AfterFake is called after every field of the struct is filled, for the value
+// passed to FakeData as well as for nested structs, slice elements and map values, innermost first.
+// It may be implemented on the type or on a pointer to it.
+type AfterFaker interface {
+	AfterFake(ctx context.Context) error
+}
+
+// BeforeFieldHook is called before a struct field is generated. parent is the struct being built,
+// holding the fields generated so far, and the path of the field is available through
+// FieldPathFromContext. Returning ErrSkipField leaves the field as it is, e.g. after the hook set it.
+type BeforeFieldHook func(ctx context.Context, parent reflect.Value, field reflect.StructField) error
+
+// AfterValueHook is called with the settable value of every struct field once it is generated, and
+// with the value passed to FakeData, whose path is empty, once it is filled.
+type AfterValueHook func(ctx context.Context, v reflect.Value) error
+
+var afterFakerType = reflect.TypeOf((*AfterFaker)(nil)).Elem()
+
+// AddBeforeFieldHook registers hook to be called before every struct field is generated. Hooks are
+// called in the order they are added.
+func (f *FakeGenerator) AddBeforeFieldHook(hook BeforeFieldHook) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.beforeFieldHooks = append(f.beforeFieldHooks, hook)
+}
+
+// AddAfterValueHook registers hook to be called after every struct field and every value passed to
+// FakeData is generated. Hooks are called in the order they are added.
+func (f *FakeGenerator) AddAfterValueHook(hook AfterValueHook) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.afterValueHooks = append(f.afterValueHooks, hook)
+}
+
+// beforeField calls the BeforeField hooks for field of the struct v, and reports whether the field
+// should still be generated.
+func (f *FakeGenerator) beforeField(ctx context.Context, v reflect.Value, field reflect.StructField) (bool, error) {
+	for _, hook := range f.beforeFieldHooks {
+		if err := hook(ctx, v, field); err != nil {
+			if errors.Is(err, ErrSkipField) {
+				return false, nil
+			}
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (f *FakeGenerator) afterValue(ctx context.Context, v reflect.Value) error {
+	for _, hook := range f.afterValueHooks {
+		if err := hook(ctx, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// afterFake calls AfterFake on the struct v if its type, or a pointer to it, implements AfterFaker.
+// v must be addressable.
+func afterFake(ctx context.Context, v reflect.Value) error {
+	switch {
+	case v.Type().Implements(afterFakerType):
+		return v.Interface().(AfterFaker).AfterFake(ctx)
+	case reflect.PtrTo(v.Type()).Implements(afterFakerType):
+		return v.Addr().Interface().(AfterFaker).AfterFake(ctx)
+	}
+	return nil
+}
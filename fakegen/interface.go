@@ -0,0 +1,101 @@
+package fakegen
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// implementation is a concrete type registered for an interface, picked proportionally to its weight.
+type implementation struct {
+	typ    reflect.Type
+	weight int
+}
+
+// AddInterfaceImplementation registers impl as a concrete type used to fill values of the interface
+// type iface. When several implementations are registered one is picked at random for every value.
+// Example:
+// 		generator.AddInterfaceImplementation(reflect.TypeOf((*Shape)(nil)).Elem(), reflect.TypeOf(&Circle{}))
+func (f *FakeGenerator) AddInterfaceImplementation(iface, impl reflect.Type) error {
+	return f.AddWeightedInterfaceImplementation(iface, impl, 1)
+}
+
+// AddWeightedInterfaceImplementation registers impl for the interface type iface like
+// AddInterfaceImplementation, picking it with a probability proportional to weight.
+func (f *FakeGenerator) AddWeightedInterfaceImplementation(iface, impl reflect.Type, weight int) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if iface == nil || iface.Kind() != reflect.Interface {
+		return fmt.Errorf("%w: %s", ErrNotInterface, iface)
+	}
+	if impl == nil || !impl.Implements(iface) {
+		return fmt.Errorf("%w: %s does not implement %s", ErrNotImplemented, impl, iface)
+	}
+	if weight <= 0 {
+		return fmt.Errorf("%w: %d", ErrWeightNotPositive, weight)
+	}
+	f.interfaceImpls[iface] = append(f.interfaceImpls[iface], implementation{typ: impl, weight: weight})
+	return nil
+}
+
+// getInterfaceValue fakes one of the implementations registered for the interface type t. The empty
+// interface falls back to a JSON like value when nothing is registered for it, a scalar one in map keys.
+// An implementation whose struct type reached the recursion limit leaves the value nil.
+func (f *FakeGenerator) getInterfaceValue(ctx context.Context, t reflect.Type) (reflect.Value, error) {
+	impls := f.interfaceImpls[t]
+	if len(impls) == 0 {
+		if t.NumMethod() == 0 {
+			return reflect.ValueOf(f.jsonValue(ctx, !isMapKey(ctx))), nil
+		}
+		return reflect.Value{}, fmt.Errorf("%w: %s", ErrNoImplementation, t)
+	}
+
+	total := 0
+	for _, impl := range impls {
+		total += impl.weight
+	}
+	pick := RandFromContext(ctx).Intn(total)
+	for _, impl := range impls {
+		if pick < impl.weight {
+			// the implementation may hold the interface again, like a group of shapes
+			if f.isRecursionLimitReached(ctx, impl.typ) {
+				return reflect.Zero(t), nil
+			}
+			return f.newValue(ctx, impl.typ)
+		}
+		pick -= impl.weight
+	}
+	return reflect.Value{}, fmt.Errorf("%w: %s", ErrNoImplementation, t)
+}
+
+// jsonValue returns a random value as encoding/json would decode it into an interface{}: a string,
+// float64 or bool, or when nested is set a []interface{} or map[string]interface{} of those.
+func (f *FakeGenerator) jsonValue(ctx context.Context, nested bool) interface{} {
+	r := RandFromContext(ctx)
+	kinds := 3
+	if nested {
+		kinds = 5
+	}
+	switch r.Intn(kinds) {
+	case 0:
+		return randomString(r, f.randomStringLen)
+	case 1:
+		return float64(randomIntegerWithBoundary(r, f.nBoundary))
+	case 2:
+		return r.Intn(2) > 0
+	case 3:
+		size := f.randomSliceAndMapSize(ctx, nil)
+		list := make([]interface{}, size)
+		for i := range list {
+			list[i] = f.jsonValue(ctx, false)
+		}
+		return list
+	default:
+		size := f.randomSliceAndMapSize(ctx, nil)
+		object := make(map[string]interface{}, size)
+		for i := 0; i < size; i++ {
+			object[randomString(r, f.randomStringLen)] = f.jsonValue(ctx, false)
+		}
+		return object
+	}
+}
@@ -39,6 +39,9 @@ func GetNetworker() Networker {
 
 // SetNetwork sets custom Network
 func SetNetwork(net Networker) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	internet = net
 }
 
@@ -57,141 +60,141 @@ type Networker interface {
 // Internet struct
 type Internet struct{}
 
-func (internet Internet) email() string {
-	return RandomString(7) + "@" + RandomString(5) + "." + RandomElementFromSliceString(tld)
+func (internet Internet) email(r *rand.Rand) string {
+	return randomString(r, 7) + "@" + randomString(r, 5) + "." + randomElementFromSliceString(r, tld)
 }
 
 // Email generates random email id
 func (internet Internet) Email(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return internet.email(), nil
+	return internet.email(RandFromContext(ctx)), nil
 }
 
 // Email get email randomly in string
 func Email() string {
 	i := Internet{}
-	return i.email()
+	return i.email(globalRand)
 }
 
-func (internet Internet) macAddress() string {
+func (internet Internet) macAddress(r *rand.Rand) string {
 	ip := make([]byte, 6)
 	for i := 0; i < 6; i++ {
-		ip[i] = byte(rand.Intn(256))
+		ip[i] = byte(r.Intn(256))
 	}
 	return net.HardwareAddr(ip).String()
 }
 
 // MacAddress generates random MacAddress
 func (internet Internet) MacAddress(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return internet.macAddress(), nil
+	return internet.macAddress(RandFromContext(ctx)), nil
 }
 
 // MacAddress get mac address randomly in string
 func MacAddress() string {
 	i := Internet{}
-	return i.macAddress()
+	return i.macAddress(globalRand)
 }
 
-func (internet Internet) domainName() string {
-	return RandomString(7) + "." + RandomElementFromSliceString(tld)
+func (internet Internet) domainName(r *rand.Rand) string {
+	return randomString(r, 7) + "." + randomElementFromSliceString(r, tld)
 }
 
 // DomainName generates random domain name
 func (internet Internet) DomainName(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return internet.domainName(), nil
+	return internet.domainName(RandFromContext(ctx)), nil
 }
 
 // DomainName get email domain name in string
 func DomainName() string {
 	i := Internet{}
-	return i.domainName()
+	return i.domainName(globalRand)
 }
 
-func (internet Internet) url() string {
-	format := RandomElementFromSliceString(urlFormats)
+func (internet Internet) url(r *rand.Rand) string {
+	format := randomElementFromSliceString(r, urlFormats)
 	countVerbs := strings.Count(format, "%s")
 	if countVerbs == 1 {
-		return fmt.Sprintf(format, internet.domainName())
+		return fmt.Sprintf(format, internet.domainName(r))
 	}
-	return fmt.Sprintf(format, internet.domainName(), internet.username())
+	return fmt.Sprintf(format, internet.domainName(r), internet.username(r))
 }
 
 // URL generates random URL standardised in urlFormats const
 func (internet Internet) URL(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return internet.url(), nil
+	return internet.url(RandFromContext(ctx)), nil
 }
 
 // URL get Url randomly in string
 func URL() string {
 	i := Internet{}
-	return i.url()
+	return i.url(globalRand)
 }
 
-func (internet Internet) username() string {
-	return RandomString(7)
+func (internet Internet) username(r *rand.Rand) string {
+	return randomString(r, 7)
 }
 
 // UserName generates random username
 func (internet Internet) UserName(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return internet.username(), nil
+	return internet.username(RandFromContext(ctx)), nil
 }
 
 // Username get username randomly in string
 func Username() string {
 	i := Internet{}
-	return i.username()
+	return i.username(globalRand)
 }
 
-func (internet Internet) ipv4() string {
+func (internet Internet) ipv4(r *rand.Rand) string {
 	size := 4
 	ip := make([]byte, size)
 	for i := 0; i < size; i++ {
-		ip[i] = byte(rand.Intn(256))
+		ip[i] = byte(r.Intn(256))
 	}
 	return net.IP(ip).To4().String()
 }
 
 // IPv4 generates random IPv4 address
 func (internet Internet) IPv4(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return internet.ipv4(), nil
+	return internet.ipv4(RandFromContext(ctx)), nil
 }
 
 // IPv4 get IPv4 randomly in string
 func IPv4() string {
 	i := Internet{}
-	return i.ipv4()
+	return i.ipv4(globalRand)
 }
 
-func (internet Internet) ipv6() string {
+func (internet Internet) ipv6(r *rand.Rand) string {
 	size := 16
 	ip := make([]byte, size)
 	for i := 0; i < size; i++ {
-		ip[i] = byte(rand.Intn(256))
+		ip[i] = byte(r.Intn(256))
 	}
 	return net.IP(ip).To16().String()
 }
 
 // IPv6 generates random IPv6 address
 func (internet Internet) IPv6(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return internet.ipv6(), nil
+	return internet.ipv6(RandFromContext(ctx)), nil
 }
 
 // IPv6 get IPv6 randomly in string
 func IPv6() string {
 	i := Internet{}
-	return i.ipv6()
+	return i.ipv6(globalRand)
 }
 
-func (internet Internet) password() string {
-	return RandomString(50)
+func (internet Internet) password(r *rand.Rand) string {
+	return randomString(r, 50)
 }
 
 // Password returns a hashed password
 func (internet Internet) Password(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return internet.password(), nil
+	return internet.password(RandFromContext(ctx)), nil
 }
 
 // Password get password randomly in string
 func Password() string {
 	i := Internet{}
-	return i.password()
+	return i.password(globalRand)
 }
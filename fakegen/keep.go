@@ -0,0 +1,122 @@
+package fakegen
+
+import (
+	"context"
+	"reflect"
+)
+
+// SetFillZeroOnly makes FakeData only generate the fields of the value passed that are still zero, so a
+// test can set the few fields it cares about and let the generator fill the rest. Nested structs, and
+// structs behind pointers or in slices and arrays, are completed the same way, while other non zero
+// fields, non empty slices and maps and fields tagged with keep are left as they are.
+func (f *FakeGenerator) SetFillZeroOnly(fillZeroOnly bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.fillZeroOnly = fillZeroOnly
+}
+
+// isZero reports whether v holds the zero value of its type, counting empty slices and maps as zero.
+func isZero(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+	return v.IsZero()
+}
+
+// keepField sets field to its non zero original value. When the generator only fills zero values, the
+// zero fields of untagged structs reached from original are generated.
+func (f *FakeGenerator) keepField(ctx context.Context, original, field reflect.Value, tags structTag) error {
+	if !f.fillZeroOnly || tags.keepOriginal || tags.fieldType != "" || tags.derive != nil {
+		field.Set(original)
+		return nil
+	}
+	val, err := f.fillZero(ctx, original)
+	if err != nil {
+		return err
+	}
+	field.Set(val)
+	return nil
+}
+
+// fillZero returns the non zero value v with the zero fields of its structs generated. Structs behind
+// pointers are completed in place, so the pointers the caller holds stay valid, and only once, so
+// cycles like a back pointer to a parent end, while structs, slices and arrays are copied. Nil or zero
+// values behind pointers are generated.
+func (f *FakeGenerator) fillZero(ctx context.Context, v reflect.Value) (reflect.Value, error) {
+	if !f.hasFillableStruct(v.Type()) {
+		return v, nil
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		return f.getValue(ctx, v.Interface())
+	case reflect.Ptr:
+		if isVisited(ctx, v) {
+			return v, nil
+		}
+		elem, err := f.fillZeroElement(withVisited(ctx, v), v.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v.Elem().Set(elem)
+		return v, nil
+	case reflect.Slice, reflect.Array:
+		list := makeList(v.Type(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elem, err := f.fillZeroElement(ctx, v.Index(i))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			list.Index(i).Set(elem)
+		}
+		return list, nil
+	}
+	return v, nil
+}
+
+// fillZeroElement completes an element of a slice or array of structs, or generates it when it is zero.
+func (f *FakeGenerator) fillZeroElement(ctx context.Context, v reflect.Value) (reflect.Value, error) {
+	if !isZero(v) {
+		return f.fillZero(ctx, v)
+	}
+	val, err := f.newValue(ctx, v.Type())
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	return val.Convert(v.Type()), nil
+}
+
+type visitedKey struct{}
+
+// withVisited returns ctx recording that the value the pointer p points to is being completed.
+func withVisited(ctx context.Context, p reflect.Value) context.Context {
+	visited, _ := ctx.Value(visitedKey{}).(map[uintptr]bool)
+	if visited == nil {
+		visited = make(map[uintptr]bool)
+		ctx = context.WithValue(ctx, visitedKey{}, visited)
+	}
+	visited[p.Pointer()] = true
+	return ctx
+}
+
+// isVisited reports whether the value the pointer p points to is already being completed.
+func isVisited(ctx context.Context, p reflect.Value) bool {
+	visited, _ := ctx.Value(visitedKey{}).(map[uintptr]bool)
+	return visited[p.Pointer()]
+}
+
+// hasFillableStruct reports whether t is, or points to or lists, a struct whose fields the generator
+// fills one by one, as opposed to structs generated as a whole by a provider, their Fake method or
+// the generator itself, like time.Time.
+func (f *FakeGenerator) hasFillableStruct(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		if _, ok := f.typeProviders[t]; ok {
+			return false
+		}
+		t = t.Elem()
+	}
+	if _, ok := f.typeProviders[t]; ok {
+		return false
+	}
+	return t.Kind() == reflect.Struct && !isFakeable(t) && !isStandardType(t)
+}
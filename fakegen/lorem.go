@@ -58,6 +58,9 @@ type DataFaker interface {
 
 // SetDataFaker sets Custom data in lorem
 func SetDataFaker(d DataFaker) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	lorem = d
 }
 
@@ -76,26 +79,26 @@ func GetLorem() DataFaker {
 type Lorem struct {
 }
 
-func (l Lorem) word() string {
-	return RandomElementFromSliceString(wordList)
+func (l Lorem) word(r *rand.Rand) string {
+	return randomElementFromSliceString(r, wordList)
 }
 
 // Word returns a word from the wordList const
 func (l Lorem) Word(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return l.word(), nil
+	return l.word(RandFromContext(ctx)), nil
 }
 
 // Word get a word randomly in string
 func Word() string {
 	i := Lorem{}
-	return i.word()
+	return i.word(globalRand)
 }
 
-func (l Lorem) sentence() string {
+func (l Lorem) sentence(r *rand.Rand) string {
 	sentence := ""
-	r, _ := RandomInt(1, 6)
-	size := len(r)
-	for key, val := range r {
+	words, _ := randomInt(r, 1, 6)
+	size := len(words)
+	for key, val := range words {
 		if key == 0 {
 			sentence += strings.Title(wordList[val])
 		} else {
@@ -110,21 +113,21 @@ func (l Lorem) sentence() string {
 
 // Sentence returns a sentence using the wordList const
 func (l Lorem) Sentence(ctx context.Context, v reflect.Value) (interface{}, error) {
-	sentence := l.sentence()
+	sentence := l.sentence(RandFromContext(ctx))
 	return sentence, nil
 }
 
 // Sentence get a sentence randomly in string
 func Sentence() string {
 	i := Lorem{}
-	return i.sentence()
+	return i.sentence(globalRand)
 }
 
-func (l Lorem) paragraph() string {
+func (l Lorem) paragraph(r *rand.Rand) string {
 	paragraph := ""
-	size := rand.Intn(10) + 1
+	size := r.Intn(10) + 1
 	for i := 0; i < size; i++ {
-		paragraph += l.sentence()
+		paragraph += l.sentence(r)
 		if i != size-1 {
 			paragraph += " "
 		}
@@ -134,11 +137,11 @@ func (l Lorem) paragraph() string {
 
 // Paragraph returns a series of sentences as a paragraph using the wordList const
 func (l Lorem) Paragraph(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return l.paragraph(), nil
+	return l.paragraph(RandFromContext(ctx)), nil
 }
 
 // Paragraph get a paragraph randomly in string
 func Paragraph() string {
 	i := Lorem{}
-	return i.paragraph()
+	return i.paragraph(globalRand)
 }
@@ -0,0 +1,72 @@
+package fakegen
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// SetNilProbability sets the probability of pointers, slices, maps and interfaces to be left nil.
+// It applies to struct fields as well as to slice elements, map keys and values. Defaults to 0.
+func (f *FakeGenerator) SetNilProbability(probability float64) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if err := checkProbability(probability); err != nil {
+		return err
+	}
+	f.nilProbability = probability
+	return nil
+}
+
+// SetTypeNilProbability sets the probability of values of type t to be left nil, overriding the one
+// set by SetNilProbability. t must be a pointer, slice, map or interface type, e.g.
+// reflect.TypeOf((*Address)(nil)).
+func (f *FakeGenerator) SetTypeNilProbability(t reflect.Type, probability float64) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if !isNullable(t) {
+		return fmt.Errorf("%w: %s", ErrNotNullable, t)
+	}
+	if err := checkProbability(probability); err != nil {
+		return err
+	}
+	f.typeNilProbability[t] = probability
+	return nil
+}
+
+func checkProbability(probability float64) error {
+	if probability < 0 || probability > 1 {
+		return fmt.Errorf("%w: %v", ErrProbabilityRange, probability)
+	}
+	return nil
+}
+
+func isNullable(t reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+		return true
+	}
+	return false
+}
+
+// isRandomNil decides whether a value of type t is left nil, using the probability of the nullable tag
+// of the field being generated, or else the one set for t, or else the generator's.
+func (f *FakeGenerator) isRandomNil(ctx context.Context, t reflect.Type) bool {
+	if !isNullable(t) {
+		return false
+	}
+	probability, ok := f.typeNilProbability[t]
+	if !ok {
+		probability = f.nilProbability
+	}
+	if tags, ok := fieldTagsFromContext(ctx, t); ok && tags.nullable {
+		probability = tags.nilProbability
+	}
+	if probability <= 0 {
+		return false
+	}
+	return RandFromContext(ctx).Float64() < probability
+}
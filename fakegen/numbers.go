@@ -0,0 +1,245 @@
+package fakegen
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// integerRange bounds random integers of a kind, both ends included. Signed kinds use min and max,
+// unsigned kinds umin and umax.
+type integerRange struct {
+	min, max   int64
+	umin, umax uint64
+}
+
+func isUnsigned(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+// kindRange returns the range of values of the integer type t.
+func kindRange(t reflect.Type) integerRange {
+	bits := uint(t.Bits())
+	if isUnsigned(t) {
+		return integerRange{umax: math.MaxUint64 >> (64 - bits)}
+	}
+	return integerRange{min: -1 << (bits - 1), max: 1<<(bits-1) - 1}
+}
+
+// boundaryRange returns the range of the boundary [start, end) for the integer type t, or just start
+// when start equals end, failing when no value of t fits in it.
+func boundaryRange(t reflect.Type, start, end int64) (integerRange, error) {
+	if end > start {
+		end--
+	}
+	limits := kindRange(t)
+	if isUnsigned(t) {
+		if start < 0 || end < 0 || uint64(end) > limits.umax {
+			return integerRange{}, fmt.Errorf("%w: [%d, %d] for %s", ErrBoundaryOutOfRange, start, end, t)
+		}
+		return integerRange{umin: uint64(start), umax: uint64(end)}, nil
+	}
+	if start < limits.min || end > limits.max {
+		return integerRange{}, fmt.Errorf("%w: [%d, %d] for %s", ErrBoundaryOutOfRange, start, end, t)
+	}
+	return integerRange{min: start, max: end}, nil
+}
+
+// generatorRange returns the range untagged integers of type t are generated in: the whole range of
+// the kind with SetFullNumberRange, else the generator's boundaries clamped to the range of the kind,
+// so boundaries set for wide kinds still suit fields like int8 or []byte.
+func (f *FakeGenerator) generatorRange(t reflect.Type) integerRange {
+	if f.fullNumberRange {
+		return kindRange(t)
+	}
+	start, end := int64(f.nBoundary.start), int64(f.nBoundary.end)
+	if end > start {
+		end--
+	}
+	limits := kindRange(t)
+	if isUnsigned(t) {
+		return integerRange{umin: clampUnsigned(start, limits.umax), umax: clampUnsigned(end, limits.umax)}
+	}
+	return integerRange{min: clampSigned(start, limits), max: clampSigned(end, limits)}
+}
+
+func clampSigned(n int64, limits integerRange) int64 {
+	if n < limits.min {
+		return limits.min
+	}
+	if n > limits.max {
+		return limits.max
+	}
+	return n
+}
+
+func clampUnsigned(n int64, max uint64) uint64 {
+	if n < 0 {
+		return 0
+	}
+	if uint64(n) > max {
+		return max
+	}
+	return uint64(n)
+}
+
+// randomInteger returns a random value of the integer type t within bounds. In edge values mode it
+// sometimes returns one of 0, -1 and the ends of edges instead, the range of the kind for untagged
+// integers and bounds for tagged ones.
+func (f *FakeGenerator) randomInteger(ctx context.Context, t reflect.Type, bounds, edges integerRange) reflect.Value {
+	r := RandFromContext(ctx)
+	v := reflect.New(t).Elem()
+	edge := f.isEdgeValue(ctx)
+	if isUnsigned(t) {
+		if edge {
+			v.SetUint(edgeUnsigned(r, edges))
+		} else {
+			v.SetUint(bounds.umin + randomUint64n(r, bounds.umax-bounds.umin))
+		}
+		return v
+	}
+	if edge {
+		v.SetInt(edgeSigned(r, edges))
+	} else {
+		v.SetInt(bounds.min + int64(randomUint64n(r, uint64(bounds.max)-uint64(bounds.min))))
+	}
+	return v
+}
+
+// randomUint64n returns a uniformly distributed value in [0, n], n included.
+func randomUint64n(r *rand.Rand, n uint64) uint64 {
+	if n == math.MaxUint64 {
+		return r.Uint64()
+	}
+	if n < math.MaxInt64 {
+		return uint64(r.Int63n(int64(n) + 1))
+	}
+	m := n + 1
+	threshold := -m % m
+	for {
+		if v := r.Uint64(); v >= threshold {
+			return v % m
+		}
+	}
+}
+
+func edgeSigned(r *rand.Rand, bounds integerRange) int64 {
+	edges := []int64{bounds.min, bounds.max}
+	for _, v := range []int64{0, -1} {
+		if v > bounds.min && v < bounds.max {
+			edges = append(edges, v)
+		}
+	}
+	return edges[r.Intn(len(edges))]
+}
+
+func edgeUnsigned(r *rand.Rand, bounds integerRange) uint64 {
+	edges := []uint64{bounds.umin, bounds.umax}
+	if bounds.umin < 1 && bounds.umax > 1 {
+		edges = append(edges, 1)
+	}
+	return edges[r.Intn(len(edges))]
+}
+
+// edgeFloat returns one of 0, -1 and the bounds for floats generated in [start, end).
+func edgeFloat(r *rand.Rand, boundary floatBoundary) float64 {
+	edges := []float64{boundary.start, math.Nextafter(boundary.end, boundary.start)}
+	for _, v := range []float64{0, -1} {
+		if v > boundary.start && v < boundary.end {
+			edges = append(edges, v)
+		}
+	}
+	return edges[r.Intn(len(edges))]
+}
+
+// extractIntegerFromTag generates an integer of type t within the boundary of tag, given either as
+// boundary_start and boundary_end, end excluded, or as boundary=start..end, both ends included.
+func (f *FakeGenerator) extractIntegerFromTag(ctx context.Context, tag string, t reflect.Type) (interface{}, error) {
+	var start, end string
+	inclusive := false
+	for _, option := range strings.Split(tag, comma) {
+		texts := strings.SplitN(strings.TrimSpace(option), Equals, -1)
+		if len(texts) != 2 {
+			return nil, fmt.Errorf("%w: %q", ErrWrongFormattedTag, tag)
+		}
+		switch texts[0] {
+		case BoundaryStart:
+			start = texts[1]
+		case BoundaryEnd:
+			end = texts[1]
+		case Boundary:
+			bounds := strings.Split(texts[1], "..")
+			if len(bounds) != 2 {
+				return nil, fmt.Errorf("%w: %q", ErrWrongFormattedTag, tag)
+			}
+			start, end, inclusive = bounds[0], bounds[1], true
+		default:
+			return nil, ErrTagNotSupported
+		}
+	}
+	if start == "" || end == "" {
+		return nil, ErrTagNotSupported
+	}
+
+	var bounds integerRange
+	var err error
+	if isUnsigned(t) {
+		bounds, err = parseUnsignedBoundary(t, strings.TrimSpace(start), strings.TrimSpace(end), inclusive)
+	} else {
+		bounds, err = parseSignedBoundary(t, strings.TrimSpace(start), strings.TrimSpace(end), inclusive)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return f.randomInteger(ctx, t, bounds, bounds).Interface(), nil
+}
+
+func parseSignedBoundary(t reflect.Type, start, end string, inclusive bool) (integerRange, error) {
+	min, err := strconv.ParseInt(start, 10, 64)
+	if err != nil {
+		return integerRange{}, fmt.Errorf("%w: %q", ErrWrongFormattedTag, start)
+	}
+	max, err := strconv.ParseInt(end, 10, 64)
+	if err != nil {
+		return integerRange{}, fmt.Errorf("%w: %q", ErrWrongFormattedTag, end)
+	}
+	if min > max {
+		return integerRange{}, ErrStartValueBiggerThanEnd
+	}
+	if !inclusive {
+		return boundaryRange(t, min, max)
+	}
+	if limits := kindRange(t); min < limits.min || max > limits.max {
+		return integerRange{}, fmt.Errorf("%w: [%d, %d] for %s", ErrBoundaryOutOfRange, min, max, t)
+	}
+	return integerRange{min: min, max: max}, nil
+}
+
+func parseUnsignedBoundary(t reflect.Type, start, end string, inclusive bool) (integerRange, error) {
+	min, err := strconv.ParseUint(start, 10, 64)
+	if err != nil {
+		return integerRange{}, fmt.Errorf("%w: %q", ErrWrongFormattedTag, start)
+	}
+	max, err := strconv.ParseUint(end, 10, 64)
+	if err != nil {
+		return integerRange{}, fmt.Errorf("%w: %q", ErrWrongFormattedTag, end)
+	}
+	if min > max {
+		return integerRange{}, ErrStartValueBiggerThanEnd
+	}
+	if !inclusive && max > min {
+		max--
+	}
+	if max > kindRange(t).umax {
+		return integerRange{}, fmt.Errorf("%w: [%d, %d] for %s", ErrBoundaryOutOfRange, min, max, t)
+	}
+	return integerRange{umin: min, umax: max}, nil
+}
@@ -0,0 +1,107 @@
+package fakegen
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	oneOfSeparator  = "|"
+	weightSeparator = ":"
+)
+
+// oneOfOption is a value listed in a oneof tag, converted to the field type, with its weight.
+type oneOfOption struct {
+	value  reflect.Value
+	weight int
+}
+
+// parseOneOf parses a tag like "oneof=active:70|closed:30" into values of type t. Options without
+// a weight have a weight of 1, so a value containing a colon must always be followed by a weight.
+func parseOneOf(tag string, t reflect.Type) ([]oneOfOption, error) {
+	list := strings.TrimPrefix(tag, OneOf+Equals)
+	if strings.TrimSpace(list) == "" || strings.Contains(list, comma) {
+		return nil, fmt.Errorf("%w: %q", ErrWrongFormattedTag, tag)
+	}
+
+	options := make([]oneOfOption, 0)
+	for _, option := range strings.Split(list, oneOfSeparator) {
+		option = strings.TrimSpace(option)
+		weight := 1
+		if i := strings.LastIndex(option, weightSeparator); i >= 0 {
+			w, err := strconv.Atoi(strings.TrimSpace(option[i+1:]))
+			if err != nil {
+				return nil, fmt.Errorf("%w: %q", ErrWrongFormattedTag, tag)
+			}
+			if w <= 0 {
+				return nil, fmt.Errorf("%w: %d", ErrWeightNotPositive, w)
+			}
+			option, weight = strings.TrimSpace(option[:i]), w
+		}
+		value, err := parseOneOfValue(option, t)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, oneOfOption{value: value, weight: weight})
+	}
+	return options, nil
+}
+
+// parseOneOfValue converts a single value of a oneof tag to the string or number type t.
+func parseOneOfValue(s string, t reflect.Type) (reflect.Value, error) {
+	v := reflect.New(t).Elem()
+	var err error
+	switch t.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		if n, err = strconv.ParseInt(s, 10, t.Bits()); err == nil {
+			v.SetInt(n)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var n uint64
+		if n, err = strconv.ParseUint(s, 10, t.Bits()); err == nil {
+			v.SetUint(n)
+		}
+	case reflect.Float32, reflect.Float64:
+		var n float64
+		if n, err = strconv.ParseFloat(s, t.Bits()); err == nil {
+			v.SetFloat(n)
+		}
+	default:
+		return reflect.Value{}, fmt.Errorf("%w: %q as %s", ErrOneOfValue, s, t)
+	}
+	if err != nil {
+		return reflect.Value{}, fmt.Errorf("%w: %q as %s", ErrOneOfValue, s, t)
+	}
+	return v, nil
+}
+
+// pickOneOf returns one of the options, with a probability proportional to its weight.
+func pickOneOf(r *rand.Rand, options []oneOfOption) reflect.Value {
+	total := 0
+	for _, option := range options {
+		total += option.weight
+	}
+	pick := r.Intn(total)
+	for _, option := range options {
+		if pick < option.weight {
+			return option.value
+		}
+		pick -= option.weight
+	}
+	return options[len(options)-1].value
+}
+
+func (f *FakeGenerator) extractOneOfFromTag(ctx context.Context, tag string, t reflect.Type) (interface{}, error) {
+	options, err := parseOneOf(tag, t)
+	if err != nil {
+		return nil, err
+	}
+	return pickOneOf(RandFromContext(ctx), options).Interface(), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/rand"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -28,9 +29,21 @@ var creditCards = map[string]creditCard{
 	"diners club":      {"Diners Club", 14, []int{36, 38, 39}},
 }
 
-var pay Render
+// creditCardTypes lists the card types in a fixed order, so picking one does not depend on map iteration order
+var creditCardTypes = func() []string {
+	keys := make([]string, 0, len(creditCards))
+	for key := range creditCards {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	types := make([]string, 0, len(keys))
+	for _, key := range keys {
+		types = append(types, creditCards[key].ccType)
+	}
+	return types
+}()
 
-var cacheCreditCard string
+var pay Render
 
 // GetPayment returns a new Render interface of Payment struct
 func GetPayment() Render {
@@ -45,6 +58,9 @@ func GetPayment() Render {
 
 // SetPayment set custom Network
 func SetPayment(p Render) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	pay = p
 }
 
@@ -57,40 +73,29 @@ type Render interface {
 // Payment struct
 type Payment struct{}
 
-func (p Payment) cctype() string {
-	n := len(creditCards)
-	if cacheCreditCard != "" {
-		return cacheCreditCard
-	}
-	var ccTypes []string
-
-	for _, cc := range creditCards {
-		ccTypes = append(ccTypes, cc.ccType)
-	}
-	cacheCreditCard = ccTypes[rand.Intn(n)]
-	return cacheCreditCard
+func (p Payment) cctype(r *rand.Rand) string {
+	return creditCardTypes[r.Intn(len(creditCardTypes))]
 }
 
 // CreditCardType returns one of the following credit values:
 // VISA, MasterCard, American Express, Discover, JCB and Diners Club
 func (p Payment) CreditCardType(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return p.cctype(), nil
+	return p.cctype(RandFromContext(ctx)), nil
 }
 
 // CCType get a credit card type randomly in string (VISA, MasterCard, etc)
 func CCType() string {
 	p := Payment{}
-	return p.cctype()
+	return p.cctype(globalRand)
 }
 
-func (p Payment) ccnumber() string {
-	ccType := p.cctype()
-	cacheCreditCard = ccType
+func (p Payment) ccnumber(r *rand.Rand) string {
+	ccType := p.cctype(r)
 	card := creditCards[strings.ToLower(ccType)]
-	prefix := strconv.Itoa(card.prefixes[rand.Intn(len(card.prefixes))])
+	prefix := strconv.Itoa(card.prefixes[r.Intn(len(card.prefixes))])
 
 	num := prefix
-	digit := RandomStringNumber(card.length - len(prefix))
+	digit := randomStringNumber(r, card.length-len(prefix))
 
 	num += digit
 	return num
@@ -98,11 +103,11 @@ func (p Payment) ccnumber() string {
 
 // CreditCardNumber generated credit card number according to the card number rules
 func (p Payment) CreditCardNumber(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return p.ccnumber(), nil
+	return p.ccnumber(RandFromContext(ctx)), nil
 }
 
 // CCNumber get a credit card number randomly in string (VISA, MasterCard, etc)
 func CCNumber() string {
 	p := Payment{}
-	return p.ccnumber()
+	return p.ccnumber(globalRand)
 }
@@ -107,7 +107,6 @@ var lastNames = []string{
 	"Ullrich", "Upton", "Vandervort", "Veum", "Volkman", "Von", "VonRueden", "Waelchi", "Walker", "Walsh", "Walter", "Ward", "Waters", "Watsica", "Weber", "Wehner", "Weimann", "Weissnat", "Welch", "West", "White", "Wiegand", "Wilderman", "Wilkinson", "Will", "Williamson", "Willms", "Windler", "Wintheiser", "Wisoky", "Wisozk", "Witting", "Wiza", "Wolf", "Wolff", "Wuckert", "Wunsch", "Wyman",
 	"Yost", "Yundt", "Zboncak", "Zemlak", "Ziemann", "Zieme", "Zulauf",
 }
-var randNameFlag = rand.Intn(100)
 
 // GetPerson returns a new Dowser interface of Person struct
 func GetPerson() Dowser {
@@ -122,6 +121,9 @@ func GetPerson() Dowser {
 
 // SetDowser sets custom Dowsers of Person names
 func SetDowser(d Dowser) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	person = d
 }
 
@@ -129,110 +131,110 @@ func SetDowser(d Dowser) {
 type Person struct {
 }
 
-func (p Person) titlemale() string {
-	return RandomElementFromSliceString(titlesMale)
+func (p Person) titlemale(r *rand.Rand) string {
+	return randomElementFromSliceString(r, titlesMale)
 }
 
 // TitleMale generates random titles for males
 func (p Person) TitleMale(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return p.titlemale(), nil
+	return p.titlemale(RandFromContext(ctx)), nil
 }
 
 // TitleMale get a title male randomly in string ("Mr.", "Dr.", "Prof.", "Lord", "King", "Prince")
 func TitleMale() string {
 	p := Person{}
-	return p.titlemale()
+	return p.titlemale(globalRand)
 }
 
-func (p Person) titleFemale() string {
-	return RandomElementFromSliceString(titlesFemale)
+func (p Person) titleFemale(r *rand.Rand) string {
+	return randomElementFromSliceString(r, titlesFemale)
 }
 
 // TitleFeMale generates random titles for females
 func (p Person) TitleFeMale(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return p.titleFemale(), nil
+	return p.titleFemale(RandFromContext(ctx)), nil
 }
 
 // TitleFemale get a title female randomly in string ("Mrs.", "Ms.", "Miss", "Dr.", "Prof.", "Lady", "Queen", "Princess")
 func TitleFemale() string {
 	p := Person{}
-	return p.titleFemale()
+	return p.titleFemale(globalRand)
 }
 
-func (p Person) firstname() string {
-	return RandomElementFromSliceString(firstNames)
+func (p Person) firstname(r *rand.Rand) string {
+	return randomElementFromSliceString(r, firstNames)
 }
 
 // FirstName retuns first names
 func (p Person) FirstName(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return p.firstname(), nil
+	return p.firstname(RandFromContext(ctx)), nil
 }
 
 // FirstName get fake firstname
 func FirstName() string {
 	p := Person{}
-	return p.firstname()
+	return p.firstname(globalRand)
 }
 
-func (p Person) firstnamemale() string {
-	return RandomElementFromSliceString(firstNamesMale)
+func (p Person) firstnamemale(r *rand.Rand) string {
+	return randomElementFromSliceString(r, firstNamesMale)
 }
 
 // FirstNameMale retuns first names for males
 func (p Person) FirstNameMale(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return p.firstnamemale(), nil
+	return p.firstnamemale(RandFromContext(ctx)), nil
 }
 
 // FirstNameMale get fake firstname for male
 func FirstNameMale() string {
 	p := Person{}
-	return p.firstnamemale()
+	return p.firstnamemale(globalRand)
 }
 
-func (p Person) firstnamefemale() string {
-	return RandomElementFromSliceString(firstNamesFemale)
+func (p Person) firstnamefemale(r *rand.Rand) string {
+	return randomElementFromSliceString(r, firstNamesFemale)
 }
 
 // FirstNameFemale retuns first names for females
 func (p Person) FirstNameFemale(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return p.firstnamefemale(), nil
+	return p.firstnamefemale(RandFromContext(ctx)), nil
 }
 
 // FirstNameFemale get fake firstname for female
 func FirstNameFemale() string {
 	p := Person{}
-	return p.firstnamefemale()
+	return p.firstnamefemale(globalRand)
 }
 
-func (p Person) lastname() string {
-	return RandomElementFromSliceString(lastNames)
+func (p Person) lastname(r *rand.Rand) string {
+	return randomElementFromSliceString(r, lastNames)
 }
 
 // LastName returns last name
 func (p Person) LastName(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return p.lastname(), nil
+	return p.lastname(RandFromContext(ctx)), nil
 }
 
 // LastName get fake lastname
 func LastName() string {
 	p := Person{}
-	return p.lastname()
+	return p.lastname(globalRand)
 }
 
-func (p Person) name() string {
-	if randNameFlag > 50 {
-		return fmt.Sprintf("%s %s %s", RandomElementFromSliceString(titlesFemale), RandomElementFromSliceString(firstNamesFemale), RandomElementFromSliceString(lastNames))
+func (p Person) name(r *rand.Rand) string {
+	if r.Intn(100) > 50 {
+		return fmt.Sprintf("%s %s %s", randomElementFromSliceString(r, titlesFemale), randomElementFromSliceString(r, firstNamesFemale), randomElementFromSliceString(r, lastNames))
 	}
-	return fmt.Sprintf("%s %s %s", RandomElementFromSliceString(titlesMale), RandomElementFromSliceString(firstNamesMale), RandomElementFromSliceString(lastNames))
+	return fmt.Sprintf("%s %s %s", randomElementFromSliceString(r, titlesMale), randomElementFromSliceString(r, firstNamesMale), randomElementFromSliceString(r, lastNames))
 }
 
 // Name returns a random name
 func (p Person) Name(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return p.name(), nil
+	return p.name(RandFromContext(ctx)), nil
 }
 
 // Name get fake name
 func Name() string {
 	p := Person{}
-	return p.name()
+	return p.name(globalRand)
 }
@@ -75,7 +75,6 @@ func TestNameMale(t *testing.T) {
 	if err != nil {
 		t.Error("Expected  not error, got err", err)
 	}
-	randNameFlag = 51
 	if name.(string) == "" {
 		t.Error("Expected from function name string get empty string")
 	}
@@ -85,7 +84,6 @@ func TestNameFemale(t *testing.T) {
 	if err != nil {
 		t.Error("Expected  not error, got err", err)
 	}
-	randNameFlag = 20
 	if name.(string) == "" {
 		t.Error("Expected from function name string get empty string")
 	}
@@ -135,14 +133,12 @@ func TestFakeLastName(t *testing.T) {
 
 func TestFakeNameMale(t *testing.T) {
 	name := Name()
-	randNameFlag = 51
 	if name == "" {
 		t.Error("Expected from function name string get empty string")
 	}
 }
 func TestFakeNameFemale(t *testing.T) {
 	name := Name()
-	randNameFlag = 20
 	if name == "" {
 		t.Error("Expected from function name string get empty string")
 	}
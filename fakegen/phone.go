@@ -23,6 +23,9 @@ func GetPhoner() Phoner {
 
 // SetPhoner sets custom Phoner
 func SetPhoner(p Phoner) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	phone = p
 }
 
@@ -37,66 +40,66 @@ type Phoner interface {
 type Phone struct {
 }
 
-func (p Phone) phonenumber() string {
-	randInt, _ := RandomInt(1, 10)
+func (p Phone) phonenumber(r *rand.Rand) string {
+	randInt, _ := randomInt(r, 1, 10)
 	str := strings.Join(IntToString(randInt), "")
 	return fmt.Sprintf("%s-%s-%s", str[:3], str[3:6], str[6:10])
 }
 
 // PhoneNumber generates phone numbers of type: "201-886-0269"
 func (p Phone) PhoneNumber(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return p.phonenumber(), nil
+	return p.phonenumber(RandFromContext(ctx)), nil
 }
 
 // Phonenumber get fake phone number
 func Phonenumber() string {
 	p := Phone{}
-	return p.phonenumber()
+	return p.phonenumber(globalRand)
 }
 
-func (p Phone) tollfreephonenumber() string {
+func (p Phone) tollfreephonenumber(r *rand.Rand) string {
 	out := ""
 	boxDigitsStart := []string{"777", "888"}
 
-	ints, _ := RandomInt(1, 9)
+	ints, _ := randomInt(r, 1, 9)
 	for index, v := range IntToString(ints) {
 		if index == 3 {
 			out += "-"
 		}
 		out += v
 	}
-	return fmt.Sprintf("(%s) %s", boxDigitsStart[rand.Intn(1)], out)
+	return fmt.Sprintf("(%s) %s", boxDigitsStart[r.Intn(1)], out)
 }
 
 // TollFreePhoneNumber generates phone numbers of type: "(888) 937-7238"
 func (p Phone) TollFreePhoneNumber(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return p.tollfreephonenumber(), nil
+	return p.tollfreephonenumber(RandFromContext(ctx)), nil
 }
 
 // TollFreePhoneNumber get fake TollFreePhoneNumber
 func TollFreePhoneNumber() string {
 	p := Phone{}
-	return p.tollfreephonenumber()
+	return p.tollfreephonenumber(globalRand)
 }
 
-func (p Phone) e164PhoneNumber() string {
+func (p Phone) e164PhoneNumber(r *rand.Rand) string {
 	out := ""
 	boxDigitsStart := []string{"7", "8"}
-	ints, _ := RandomInt(1, 10)
+	ints, _ := randomInt(r, 1, 10)
 
 	for _, v := range IntToString(ints) {
 		out += v
 	}
-	return fmt.Sprintf("+%s%s", boxDigitsStart[rand.Intn(1)], strings.Join(IntToString(ints), ""))
+	return fmt.Sprintf("+%s%s", boxDigitsStart[r.Intn(1)], strings.Join(IntToString(ints), ""))
 }
 
 // E164PhoneNumber generates phone numbers of type: "+27113456789"
 func (p Phone) E164PhoneNumber(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return p.e164PhoneNumber(), nil
+	return p.e164PhoneNumber(RandFromContext(ctx)), nil
 }
 
 // E164PhoneNumber get fake E164PhoneNumber
 func E164PhoneNumber() string {
 	p := Phone{}
-	return p.e164PhoneNumber()
+	return p.e164PhoneNumber(globalRand)
 }
@@ -0,0 +1,103 @@
+package fakegen
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// structPlan is the result of analysing a struct type once: the fields to fill, in order,
+// with their decoded tags.
+type structPlan struct {
+	fields []fieldPlan
+}
+
+type fieldPlan struct {
+	index     int
+	name      string
+	tags      structTag
+	unique    bool
+	embedding *embedding
+}
+
+// planKey identifies a plan. Field tags and filters can match fields by their path, so the plan of
+// a struct type depends on where it is met in the value graph.
+type planKey struct {
+	typ  reflect.Type
+	root reflect.Type
+	path string
+}
+
+// planCache holds the plans of the struct types met by a generator. It is cleared whenever
+// the configuration the plans depend on changes.
+type planCache struct {
+	mu    sync.RWMutex
+	plans map[planKey]*structPlan
+}
+
+func newPlanCache() *planCache {
+	return &planCache{plans: make(map[planKey]*structPlan)}
+}
+
+func (c *planCache) get(key planKey) (*structPlan, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	plan, ok := c.plans[key]
+	return plan, ok
+}
+
+func (c *planCache) put(key planKey, plan *structPlan) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.plans[key] = plan
+}
+
+func (c *planCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.plans = make(map[planKey]*structPlan)
+}
+
+// structPlan returns the plan of the struct type t generated in ctx, building and caching it on first use.
+func (f *FakeGenerator) structPlan(ctx context.Context, t reflect.Type) (*structPlan, error) {
+	path := FieldPathFromContext(ctx)
+	key := planKey{typ: t, root: rootTypeFromContext(ctx), path: path}
+	if plan, ok := f.plans.get(key); ok {
+		return plan, nil
+	}
+
+	plan := &structPlan{fields: make([]fieldPlan, 0, t.NumField())}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue // to avoid panic to set on unexported field in struct
+		}
+		names := fieldNames(ctx, t, i, path)
+		if f.isExcluded(names) {
+			continue
+		}
+		tags, err := f.decodeTags(t, i, names)
+		if err != nil {
+			return nil, newFieldError(t, joinPath(path, field.Name), f.fullTag(t, i, names), err)
+		}
+		if tags.nullable && !isNullable(field.Type) {
+			err := fmt.Errorf("%w: %s", ErrNotNullable, field.Type)
+			return nil, newFieldError(t, joinPath(path, field.Name), tags.tag, err)
+		}
+		plan.fields = append(plan.fields, fieldPlan{
+			index:     i,
+			name:      field.Name,
+			tags:      tags,
+			unique:    f.isUnique(tags),
+			embedding: childEmbedding(ctx, t, i, path),
+		})
+	}
+	fields, err := orderDerived(t, path, plan.fields)
+	if err != nil {
+		return nil, err
+	}
+	plan.fields = fields
+	f.plans.put(key, plan)
+	return plan, nil
+}
@@ -58,33 +58,36 @@ func GetPrice() Money {
 
 // SetPrice sets custom Money
 func SetPrice(p Money) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	pri = p
 }
 
-func (p Price) currency() string {
-	return RandomElementFromSliceString(currencies)
+func (p Price) currency(r *rand.Rand) string {
+	return randomElementFromSliceString(r, currencies)
 }
 
 // Currency returns a random currency from currencies
 func (p Price) Currency(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return p.currency(), nil
+	return p.currency(RandFromContext(ctx)), nil
 }
 
 // Currency get fake Currency (IDR, USD)
 func Currency() string {
 	p := Price{}
-	return p.currency()
+	return p.currency(globalRand)
 }
 
-func (p Price) amount() float64 {
-	return precision(rand.Float64()*math.Pow10(rand.Intn(8)), rand.Intn(2)+1)
+func (p Price) amount(r *rand.Rand) float64 {
+	return precision(r.Float64()*math.Pow10(r.Intn(8)), r.Intn(2)+1)
 }
 
 // Amount returns a random floating price amount
 // with a random precision of [1,2] up to (10**8 - 1)
 func (p Price) Amount(ctx context.Context, v reflect.Value) (interface{}, error) {
 	kind := v.Kind()
-	val := p.amount()
+	val := p.amount(RandFromContext(ctx))
 	if kind == reflect.Float32 {
 		v.Set(reflect.ValueOf(float32(val)))
 		return float32(val), nil
@@ -93,20 +96,20 @@ func (p Price) Amount(ctx context.Context, v reflect.Value) (interface{}, error)
 	return val, nil
 }
 
-func (p Price) amountwithcurrency() string {
-	val := p.amount()
-	return fmt.Sprintf("%s %f", p.currency(), val)
+func (p Price) amountwithcurrency(r *rand.Rand) string {
+	val := p.amount(r)
+	return fmt.Sprintf("%s %f", p.currency(r), val)
 }
 
 // AmountWithCurrency combines both price and currency together
 func (p Price) AmountWithCurrency(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return p.amountwithcurrency(), nil
+	return p.amountwithcurrency(RandFromContext(ctx)), nil
 }
 
 // AmountWithCurrency get fake AmountWithCurrency  USD 49257.100
 func AmountWithCurrency() string {
 	p := Price{}
-	return p.amountwithcurrency()
+	return p.amountwithcurrency(globalRand)
 }
 
 // precision | a helper function to set precision of price
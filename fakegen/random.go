@@ -0,0 +1,55 @@
+package fakegen
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// globalSource adapts the package level math/rand functions to a rand.Source64,
+// so helpers called outside of a FakeGenerator keep using the shared, locked source.
+type globalSource struct{}
+
+func (globalSource) Int63() int64    { return rand.Int63() }
+func (globalSource) Uint64() uint64  { return rand.Uint64() }
+func (globalSource) Seed(seed int64) { rand.Seed(seed) }
+
+// globalRand is used by the standalone helpers (Email, Name, RandomString, ...)
+// and by providers invoked without a generator scoped source in their context.
+var globalRand = rand.New(globalSource{})
+
+// lockedSource guards the source of a generator, which is shared by every goroutine faking with it.
+type lockedSource struct {
+	mu  sync.Mutex
+	src rand.Source
+}
+
+func newLockedSource(src rand.Source) *lockedSource {
+	return &lockedSource{src: src}
+}
+
+func (s *lockedSource) Int63() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.src.Int63()
+}
+
+// Uint64 draws like rand.Rand.Uint64 would from the wrapped source, so wrapping it does not change the output.
+func (s *lockedSource) Uint64() uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if src64, ok := s.src.(rand.Source64); ok {
+		return src64.Uint64()
+	}
+	return uint64(s.src.Int63())>>31 | uint64(s.src.Int63())<<32
+}
+
+func (s *lockedSource) Seed(seed int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.src.Seed(seed)
+}
+
+func init() {
+	rand.Seed(time.Now().UnixNano())
+}
@@ -0,0 +1,129 @@
+package fakegen
+
+import (
+	"fmt"
+	"math/rand"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+)
+
+const (
+	// regexMaxRepeat bounds the repetitions generated for *, + and open ended {n,} in a pattern
+	regexMaxRepeat = 10
+	printableStart = ' '
+	printableEnd   = '~'
+)
+
+// RandomStringMatching returns a random string matching the regular expression pattern.
+// Repetitions like * and + produce at most 10 repeats.
+func RandomStringMatching(pattern string) (string, error) {
+	return randomStringMatching(globalRand, pattern)
+}
+
+func randomStringMatching(r *rand.Rand, pattern string) (string, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrWrongFormattedTag, err)
+	}
+	var b strings.Builder
+	if err := writeMatching(r, &b, re.Simplify()); err != nil {
+		return "", fmt.Errorf("%w: %q", ErrRegexNoMatch, pattern)
+	}
+	return b.String(), nil
+}
+
+func writeMatching(r *rand.Rand, b *strings.Builder, re *syntax.Regexp) error {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		return fmt.Errorf("%w: %q", ErrRegexNoMatch, re)
+	case syntax.OpLiteral:
+		for _, c := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 && r.Intn(2) > 0 {
+				c = unicode.SimpleFold(c)
+			}
+			b.WriteRune(c)
+		}
+	case syntax.OpCharClass:
+		c, ok := randomRuneInClass(r, re.Rune)
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrRegexNoMatch, re)
+		}
+		b.WriteRune(c)
+	case syntax.OpAnyCharNotNL, syntax.OpAnyChar:
+		b.WriteRune(printableStart + rune(r.Intn(printableEnd-printableStart+1)))
+	case syntax.OpCapture:
+		return writeMatching(r, b, re.Sub[0])
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		min, max := repeatBounds(re)
+		for i := min + r.Intn(max-min+1); i > 0; i-- {
+			if err := writeMatching(r, b, re.Sub[0]); err != nil {
+				return err
+			}
+		}
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if err := writeMatching(r, b, sub); err != nil {
+				return err
+			}
+		}
+	case syntax.OpAlternate:
+		return writeMatching(r, b, re.Sub[r.Intn(len(re.Sub))])
+	}
+	// empty matches, line and text anchors and word boundaries do not produce any output
+	return nil
+}
+
+// repeatBounds returns the inclusive number of repetitions to pick from for a repeat operator
+func repeatBounds(re *syntax.Regexp) (int, int) {
+	switch re.Op {
+	case syntax.OpStar:
+		return 0, regexMaxRepeat
+	case syntax.OpPlus:
+		return 1, regexMaxRepeat
+	case syntax.OpQuest:
+		return 0, 1
+	}
+	if re.Max < 0 {
+		return re.Min, re.Min + regexMaxRepeat
+	}
+	return re.Min, re.Max
+}
+
+// randomRuneInClass picks a rune from the ranges of a character class, given as pairs of inclusive bounds.
+// Printable ASCII characters are preferred so negated classes such as [^\s] stay readable.
+func randomRuneInClass(r *rand.Rand, ranges []rune) (rune, bool) {
+	printable := make([]rune, 0, len(ranges))
+	for i := 0; i+1 < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+		if lo < printableStart {
+			lo = printableStart
+		}
+		if hi > printableEnd {
+			hi = printableEnd
+		}
+		if lo <= hi {
+			printable = append(printable, lo, hi)
+		}
+	}
+	if len(printable) > 0 {
+		ranges = printable
+	}
+
+	total := 0
+	for i := 0; i+1 < len(ranges); i += 2 {
+		total += int(ranges[i+1]-ranges[i]) + 1
+	}
+	if total == 0 {
+		return 0, false
+	}
+	pick := r.Intn(total)
+	for i := 0; i+1 < len(ranges); i += 2 {
+		size := int(ranges[i+1]-ranges[i]) + 1
+		if pick < size {
+			return ranges[i] + rune(pick), true
+		}
+		pick -= size
+	}
+	return 0, false
+}
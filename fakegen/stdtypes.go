@@ -0,0 +1,146 @@
+package fakegen
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"math/big"
+	"math/rand"
+	"net"
+	"net/url"
+	"reflect"
+	"regexp"
+	"time"
+)
+
+var (
+	timeType         = reflect.TypeOf(time.Time{})
+	durationType     = reflect.TypeOf(time.Duration(0))
+	locationType     = reflect.TypeOf(time.Location{})
+	ipType           = reflect.TypeOf(net.IP{})
+	ipNetType        = reflect.TypeOf(net.IPNet{})
+	hardwareAddrType = reflect.TypeOf(net.HardwareAddr{})
+	urlType          = reflect.TypeOf(url.URL{})
+	bigIntType       = reflect.TypeOf(big.Int{})
+	bigFloatType     = reflect.TypeOf(big.Float{})
+	rawMessageType   = reflect.TypeOf(json.RawMessage{})
+	regexpType       = reflect.TypeOf(regexp.Regexp{})
+	nullStringType   = reflect.TypeOf(sql.NullString{})
+	nullInt64Type    = reflect.TypeOf(sql.NullInt64{})
+	nullInt32Type    = reflect.TypeOf(sql.NullInt32{})
+	nullFloat64Type  = reflect.TypeOf(sql.NullFloat64{})
+	nullBoolType     = reflect.TypeOf(sql.NullBool{})
+	nullTimeType     = reflect.TypeOf(sql.NullTime{})
+)
+
+// samplePatterns are used to fake regexp.Regexp values
+var samplePatterns = []string{
+	`^[a-z]+$`,
+	`^[A-Z]{3}-\d{4}$`,
+	`^\d{5}(-\d{4})?$`,
+	`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-z]{2,}$`,
+	`^https?://[^\s/$.?#].[^\s]*$`,
+	`^#?([a-f0-9]{6}|[a-f0-9]{3})$`,
+	`\b\w+\b`,
+	`(\d+)\.(\d+)\.(\d+)`,
+}
+
+// getStandardValue generates values for well-known standard library types, whose generic struct or
+// kind based generation would be meaningless or fail. ok is false for any other type.
+func (f *FakeGenerator) getStandardValue(ctx context.Context, t reflect.Type) (val reflect.Value, ok bool, err error) {
+	r := RandFromContext(ctx)
+
+	switch t {
+	case timeType:
+		ft := ReferenceTimeFromContext(ctx).Add(time.Duration(r.Int63()))
+		return reflect.ValueOf(ft), true, nil
+	case durationType:
+		return reflect.ValueOf(time.Duration(r.Int63n(int64(24 * time.Hour)))), true, nil
+	case locationType:
+		name := randomElementFromSliceString(r, timezones)
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			loc = time.FixedZone(name, (r.Intn(27)-12)*int(time.Hour/time.Second))
+		}
+		return reflect.ValueOf(loc).Elem(), true, nil
+	case ipType:
+		return reflect.ValueOf(randomIP(r)), true, nil
+	case ipNetType:
+		ip := randomIP(r)
+		mask := net.CIDRMask(r.Intn(8*len(ip)+1), 8*len(ip))
+		return reflect.ValueOf(net.IPNet{IP: ip.Mask(mask), Mask: mask}), true, nil
+	case hardwareAddrType:
+		addr := make(net.HardwareAddr, 6)
+		for i := range addr {
+			addr[i] = byte(r.Intn(256))
+		}
+		return reflect.ValueOf(addr), true, nil
+	case urlType:
+		u, err := url.Parse(Internet{}.url(r))
+		if err != nil {
+			return reflect.Value{}, true, err
+		}
+		return reflect.ValueOf(u).Elem(), true, nil
+	case bigIntType:
+		max := new(big.Int).Lsh(big.NewInt(1), uint(r.Intn(128)+1))
+		n := new(big.Int).Rand(r, max)
+		if r.Intn(2) > 0 {
+			n.Neg(n)
+		}
+		return reflect.ValueOf(n).Elem(), true, nil
+	case bigFloatType:
+		n := big.NewFloat((r.Float64()*2 - 1) * float64(randomIntegerWithBoundary(r, f.nBoundary)))
+		return reflect.ValueOf(n).Elem(), true, nil
+	case rawMessageType:
+		raw, err := json.Marshal(f.jsonValue(ctx, true))
+		if err != nil {
+			return reflect.Value{}, true, err
+		}
+		return reflect.ValueOf(json.RawMessage(raw)), true, nil
+	case regexpType:
+		re := regexp.MustCompile(randomElementFromSliceString(r, samplePatterns))
+		return reflect.ValueOf(re).Elem(), true, nil
+	case nullStringType, nullInt64Type, nullInt32Type, nullFloat64Type, nullBoolType, nullTimeType:
+		val, err := f.getNullValue(ctx, t)
+		return val, true, err
+	}
+	return reflect.Value{}, false, nil
+}
+
+// isStandardType reports whether values of type t are generated by getStandardValue.
+func isStandardType(t reflect.Type) bool {
+	switch t {
+	case timeType, durationType, locationType, ipType, ipNetType, hardwareAddrType, urlType, bigIntType, bigFloatType,
+		rawMessageType, regexpType, nullStringType, nullInt64Type, nullInt32Type, nullFloat64Type, nullBoolType, nullTimeType:
+		return true
+	}
+	return false
+}
+
+// getNullValue fakes one of the database/sql Null types, whose first field holds the value and
+// whose Valid field tells whether it is set. The value is only generated when Valid is true.
+func (f *FakeGenerator) getNullValue(ctx context.Context, t reflect.Type) (reflect.Value, error) {
+	v := reflect.New(t).Elem()
+	if RandFromContext(ctx).Intn(2) == 0 {
+		return v, nil
+	}
+	val, err := f.newValue(ctx, t.Field(0).Type)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	v.Field(0).Set(val.Convert(t.Field(0).Type))
+	v.FieldByName("Valid").SetBool(true)
+	return v, nil
+}
+
+func randomIP(r *rand.Rand) net.IP {
+	size := net.IPv4len
+	if r.Intn(2) > 0 {
+		size = net.IPv6len
+	}
+	ip := make(net.IP, size)
+	for i := range ip {
+		ip[i] = byte(r.Intn(256))
+	}
+	return ip
+}
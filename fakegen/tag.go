@@ -0,0 +1,284 @@
+package fakegen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// tagSpec is a parsed faker tag. The grammar of a tag is
+// 		tag      = item { "," item }
+// 		item     = modifier | option | provider
+// 		modifier = "keep" | "unique" | "nullable=" probability | "size=" size
+// 		option   = key "=" value | "derive=" name "(" [ field { "," field } ] ")"
+// 		provider = name
+// with at most one provider, which can not be combined with options, and derive only combined with
+// modifiers. A regex option takes the rest of the tag as its value, since a pattern may contain commas
+// itself.
+type tagSpec struct {
+	provider       string
+	options        []tagOption
+	keepOriginal   bool
+	unique         bool
+	nullable       bool
+	nilProbability float64
+	size           *sizeRange
+	derive         *derivation
+}
+
+// tagOption is a key=value option of a tag, such as boundary_start=5.
+type tagOption struct {
+	key   string
+	value string
+}
+
+// parseTag parses tag according to the grammar of tagSpec. It only checks the syntax of options,
+// their keys and values are checked against the type of the field when a value is generated.
+func parseTag(tag string) (tagSpec, error) {
+	var spec tagSpec
+	if strings.TrimSpace(tag) == "" {
+		return spec, nil
+	}
+	items := splitItems(tag)
+	for i, item := range items {
+		item = strings.TrimSpace(item)
+		key, value, isOption := splitOption(item)
+		switch {
+		case item == "":
+		case item == keep:
+			spec.keepOriginal = true
+		case item == unique:
+			spec.unique = true
+		case key == Nullable && isOption:
+			probability, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return tagSpec{}, fmt.Errorf("%w: %q", ErrWrongFormattedTag, tag)
+			}
+			if err := checkProbability(probability); err != nil {
+				return tagSpec{}, err
+			}
+			spec.nullable, spec.nilProbability = true, probability
+		case key == Size && isOption:
+			size, err := parseSizeRange(value)
+			if err != nil {
+				return tagSpec{}, err
+			}
+			spec.size = &size
+		case key == Derive && isOption:
+			d, err := parseDerivation(value)
+			if err != nil {
+				return tagSpec{}, err
+			}
+			spec.derive = d
+		case key == Regex && isOption:
+			// a pattern may contain commas itself, so it takes the rest of the tag
+			_, value, _ := splitOption(items[i])
+			pattern := strings.Join(append([]string{value}, items[i+1:]...), comma)
+			spec.options = append(spec.options, tagOption{key: key, value: pattern})
+			return spec, spec.check(tag)
+		case isOption:
+			if key == "" {
+				return tagSpec{}, fmt.Errorf("%w: %q", ErrWrongFormattedTag, tag)
+			}
+			spec.options = append(spec.options, tagOption{key: key, value: strings.TrimSpace(value)})
+		default:
+			if spec.provider != "" {
+				return tagSpec{}, fmt.Errorf("%w: %q", ErrWrongFormattedTag, tag)
+			}
+			spec.provider = item
+		}
+	}
+	return spec, spec.check(tag)
+}
+
+// splitItems splits tag on its commas, except those separating the fields of a derive option.
+func splitItems(tag string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(tag, comma) {
+		if last := len(items) - 1; last >= 0 && isOpenDerivation(items[last]) {
+			items[last] += comma + item
+			continue
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func isOpenDerivation(item string) bool {
+	key, value, isOption := splitOption(strings.TrimSpace(item))
+	return isOption && key == Derive && strings.Count(value, argsStart) > strings.Count(value, argsEnd)
+}
+
+// splitOption splits item into the key and value of a key=value option.
+func splitOption(item string) (string, string, bool) {
+	i := strings.Index(item, Equals)
+	if i < 0 {
+		return "", "", false
+	}
+	return strings.TrimSpace(item[:i]), item[i+1:], true
+}
+
+func (s tagSpec) check(tag string) error {
+	if s.provider != "" && len(s.options) > 0 {
+		return fmt.Errorf("%w: %q", ErrWrongFormattedTag, tag)
+	}
+	if s.derive != nil && (s.provider != "" || len(s.options) > 0) {
+		return fmt.Errorf("%w: %q", ErrWrongFormattedTag, tag)
+	}
+	return nil
+}
+
+// merge adds the provider, options and modifiers of other, the tag added with AddFieldTag, to s.
+func (s tagSpec) merge(other tagSpec, tag string) (tagSpec, error) {
+	if other.provider != "" {
+		if s.provider != "" {
+			return tagSpec{}, fmt.Errorf("%w: %q", ErrWrongFormattedTag, s.fieldType()+comma+tag)
+		}
+		s.provider = other.provider
+	}
+	s.options = append(append([]tagOption{}, s.options...), other.options...)
+	s.keepOriginal = s.keepOriginal || other.keepOriginal
+	s.unique = s.unique || other.unique
+	if other.nullable {
+		s.nullable, s.nilProbability = true, other.nilProbability
+	}
+	if other.size != nil {
+		s.size = other.size
+	}
+	if other.derive != nil {
+		s.derive = other.derive
+	}
+	return s, s.check(tag)
+}
+
+// fieldType returns the provider or the options of s, as understood by setDataWithTag.
+func (s tagSpec) fieldType() string {
+	if s.provider != "" {
+		return s.provider
+	}
+	options := make([]string, 0, len(s.options))
+	for _, option := range s.options {
+		options = append(options, option.key+Equals+option.value)
+	}
+	return strings.Join(options, comma)
+}
+
+// Validate walks the type t, following pointers, slices, arrays and maps, and checks the faker tags of
+// every struct field it reaches, including tags added with AddFieldTag, without generating any data.
+// It reports every malformed tag, unknown provider or option, and option that does not suit the type
+// of its field in a *ValidationError, so misspelled tags can be caught by a unit test:
+// 		if err := generator.Validate(reflect.TypeOf(User{})); err != nil {
+// 			t.Fatal(err)
+// 		}
+func (f *FakeGenerator) Validate(t reflect.Type) error {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	// option values are checked by generating a throwaway value, which must not draw from the
+	// generator's random source
+	ctx := context.WithValue(context.Background(), randKey{}, rand.New(rand.NewSource(0)))
+	errs := f.validateType(ctx, t, nil)
+	if len(errs) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: errs}
+}
+
+func (f *FakeGenerator) validateType(ctx context.Context, t reflect.Type, errs []*FieldError) []*FieldError {
+	if t == nil {
+		return errs
+	}
+	if _, ok := f.typeProviders[t]; ok || isFakeable(t) {
+		return errs
+	}
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return f.validateType(ctx, t.Elem(), errs)
+	case reflect.Map:
+		return f.validateType(ctx, t.Elem(), f.validateType(ctx, t.Key(), errs))
+	case reflect.Struct:
+		if structTypeDepth(ctx, t) > 0 {
+			return errs
+		}
+	default:
+		return errs
+	}
+
+	ctx = withRootType(withStructType(ctx, t), t)
+	path := FieldPathFromContext(ctx)
+	fields := make([]fieldPlan, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		names := fieldNames(ctx, t, i, path)
+		if f.isExcluded(names) {
+			continue
+		}
+		fieldPath := joinPath(path, field.Name)
+		tags, err := f.decodeTags(t, i, names)
+		if err == nil {
+			err = f.validateField(ctx, field.Type, tags)
+		}
+		if err != nil {
+			errs = append(errs, newFieldError(t, fieldPath, f.fullTag(t, i, names), err).(*FieldError))
+			continue
+		}
+		fields = append(fields, fieldPlan{index: i, name: field.Name, tags: tags})
+		if tags.fieldType == "" && tags.derive == nil {
+			ctx := withEmbedding(withFieldPath(ctx, field.Name), childEmbedding(ctx, t, i, path))
+			errs = f.validateType(ctx, field.Type, errs)
+		}
+	}
+	if _, err := orderDerived(t, path, fields); err != nil {
+		errs = append(errs, err.(*FieldError))
+	}
+	return errs
+}
+
+// validateField checks the decoded tags of a field of type t.
+func (f *FakeGenerator) validateField(ctx context.Context, t reflect.Type, tags structTag) error {
+	if tags.nullable && !isNullable(t) {
+		return fmt.Errorf("%w: %s", ErrNotNullable, t)
+	}
+	if tags.size != nil && t.Kind() != reflect.Slice && t.Kind() != reflect.Map {
+		return fmt.Errorf("%w: %s on %s", ErrNotSupportedTypeForTag, Size, t)
+	}
+	switch {
+	case tags.derive != nil:
+		if _, ok := f.derivers[tags.derive.name]; !ok {
+			return fmt.Errorf("%w: %q", ErrUnknownDeriver, tags.derive.name)
+		}
+		return nil
+	case tags.fieldType == "" || tags.fieldType == SKIP:
+		return nil
+	case tags.provider != "":
+		if _, ok := f.tagProviders[tags.provider]; !ok {
+			return fmt.Errorf("%w: %q", ErrTagNotSupported, tags.provider)
+		}
+		return nil
+	}
+
+	// options are understood by the generators of strings and numbers, and of slices, arrays and maps
+	// of them, so a throwaway value shows whether they suit t
+	elems := []reflect.Type{t}
+	switch t.Kind() {
+	case reflect.Slice:
+		elems = []reflect.Type{t.Elem()}
+	case reflect.Map:
+		elems = []reflect.Type{t.Key(), t.Elem()}
+	}
+	for _, elem := range elems {
+		if _, err := f.getValueWithTag(ctx, elem, tags.fieldType); err != nil {
+			if errors.Is(err, ErrUnknownType) {
+				return fmt.Errorf("%w: %q on %s", ErrNotSupportedTypeForTag, tags.fieldType, t)
+			}
+			return err
+		}
+	}
+	return nil
+}
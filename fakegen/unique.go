@@ -0,0 +1,114 @@
+package fakegen
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// uniqueValues records the values already produced for every unique scope, which is either a tag
+// (so every field faked with `faker:"email,unique"` shares the same set) or a field path.
+type uniqueValues struct {
+	mu   sync.Mutex
+	seen map[string]map[interface{}]struct{}
+}
+
+func newUniqueValues() *uniqueValues {
+	return &uniqueValues{seen: make(map[string]map[interface{}]struct{})}
+}
+
+// add records val in scope and reports whether it was not produced before.
+func (u *uniqueValues) add(scope string, val interface{}) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	values, ok := u.seen[scope]
+	if !ok {
+		values = make(map[interface{}]struct{})
+		u.seen[scope] = values
+	}
+	if _, exists := values[val]; exists {
+		return false
+	}
+	values[val] = struct{}{}
+	return true
+}
+
+func (u *uniqueValues) reset() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.seen = make(map[string]map[interface{}]struct{})
+}
+
+// WithUniqueTags makes every field generated with one of tags unique, as if it was tagged
+// with the unique modifier, e.g. WithUniqueTags(EmailTag, UserNameTag, ID).
+func WithUniqueTags(tags ...string) Option {
+	return func(f *FakeGenerator) {
+		for _, tag := range tags {
+			f.uniqueTags[tag] = true
+		}
+	}
+}
+
+// SetUniqueRetries sets how many times a unique field is regenerated before giving up with an error
+// because its values are exhausted. Defaults to 100.
+func (f *FakeGenerator) SetUniqueRetries(retries int) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if retries < 0 {
+		return fmt.Errorf("%w: %d", ErrSmallerThanZero, retries)
+	}
+	f.uniqueRetries = retries
+	return nil
+}
+
+// ResetUnique forgets every value produced for unique fields so far, e.g. between test cases.
+func (f *FakeGenerator) ResetUnique() {
+	f.uniqueValues.reset()
+}
+
+func (f *FakeGenerator) isUnique(tags structTag) bool {
+	return tags.unique || f.uniqueTags[tags.fieldType]
+}
+
+// setUniqueField fills the i-th field of the struct v like setField, regenerating it until it holds
+// a value not produced before for its tag, or for its field path when it has no tag.
+func (f *FakeGenerator) setUniqueField(ctx context.Context, a interface{}, v reflect.Value, i int, tags structTag) error {
+	field := v.Field(i)
+	if !comparableValue(field.Type()) {
+		return fmt.Errorf("%w: %s of type %s", ErrUniqueNotComparable, FieldPathFromContext(ctx), field.Type())
+	}
+	scope := "field:" + FieldPathFromContext(ctx)
+	if tags.fieldType != "" {
+		scope = "tag:" + tags.fieldType
+	}
+
+	for attempt := 0; attempt <= f.uniqueRetries; attempt++ {
+		if err := f.setField(ctx, a, v, i, tags); err != nil {
+			return err
+		}
+		if f.uniqueValues.add(scope, uniqueKey(field)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s after %d attempts", ErrUniqueExhausted, FieldPathFromContext(ctx), f.uniqueRetries+1)
+}
+
+// uniqueKey returns the value tracked for field, which is the pointed value for pointers.
+func uniqueKey(field reflect.Value) interface{} {
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return nil
+		}
+		field = field.Elem()
+	}
+	return field.Interface()
+}
+
+// comparableValue reports whether values of t, or of the type t points to, can be compared for uniqueness.
+func comparableValue(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Comparable() && t.Kind() != reflect.Interface
+}
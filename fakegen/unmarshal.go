@@ -0,0 +1,45 @@
+package fakegen
+
+import (
+	"database/sql"
+	"encoding"
+	"encoding/json"
+	"reflect"
+)
+
+// setFromString sets v, which must be addressable, from the string s generated for a tag through the
+// parsing method of its type, so custom scalars like a validated CountryCode are populated by their
+// own logic. It tries UnmarshalText, then UnmarshalJSON, then Scan, and reports whether the type has
+// any of them. UnmarshalJSON is passed s as a JSON string for string kinds, and as is for other kinds
+// when it is valid JSON, like a number.
+func setFromString(v reflect.Value, s string) (bool, error) {
+	if !v.CanAddr() {
+		return false, nil
+	}
+	switch u := v.Addr().Interface().(type) {
+	case encoding.TextUnmarshaler:
+		return true, u.UnmarshalText([]byte(s))
+	case json.Unmarshaler:
+		if v.Kind() != reflect.String && json.Valid([]byte(s)) {
+			return true, u.UnmarshalJSON([]byte(s))
+		}
+		data, err := json.Marshal(s)
+		if err != nil {
+			return true, err
+		}
+		return true, u.UnmarshalJSON(data)
+	case sql.Scanner:
+		return true, u.Scan(s)
+	}
+	return false, nil
+}
+
+// setFromProvider sets v from res, the value returned by a tag provider, like setFromString when res is
+// a string, and reports whether it did.
+func setFromProvider(v reflect.Value, res interface{}) (bool, error) {
+	s, ok := res.(string)
+	if !ok {
+		return false, nil
+	}
+	return setFromString(v, s)
+}
@@ -2,9 +2,9 @@ package fakegen
 
 import (
 	"context"
-	"crypto/rand"
+	"encoding/binary"
 	"fmt"
-	"io"
+	"math/rand"
 	"reflect"
 )
 
@@ -31,57 +31,45 @@ type Identifier interface {
 type UUID struct{}
 
 // createUUID returns a 16 byte slice with random values
-func createUUID() ([]byte, error) {
+func createUUID(r *rand.Rand) []byte {
 	b := make([]byte, 16)
-	_, err := io.ReadFull(rand.Reader, b)
-	if err != nil {
-		return b, err
-	}
+	binary.BigEndian.PutUint64(b[:8], r.Uint64())
+	binary.BigEndian.PutUint64(b[8:], r.Uint64())
 	// variant bits; see section 4.1.1
 	b[8] = b[8]&^0xc0 | 0x80
 	// version 4 (pseudo-random); see section 4.1.3
 	b[6] = b[6]&^0xf0 | 0x40
-	return b, nil
+	return b
 }
 
-func (u UUID) hyphenated() (string, error) {
-	b, err := createUUID()
-	if err != nil {
-		return "", err
-	}
-	uuid := fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
-	return uuid, err
+func (u UUID) hyphenated(r *rand.Rand) string {
+	b := createUUID(r)
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
 }
 
 // Hyphenated returns a 36 byte hyphenated UUID
 func (u UUID) Hyphenated(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return u.hyphenated()
+	return u.hyphenated(RandFromContext(ctx)), nil
 }
 
 // UUIDHyphenated get fake Hyphenated UUID
 func UUIDHyphenated() string {
 	u := UUID{}
-	res, _ := u.hyphenated()
-	return res
+	return u.hyphenated(globalRand)
 }
 
-func (u UUID) digit() (string, error) {
-	b, err := createUUID()
-	if err != nil {
-		return "", err
-	}
-	uuid := fmt.Sprintf("%x", b)
-	return uuid, err
+func (u UUID) digit(r *rand.Rand) string {
+	b := createUUID(r)
+	return fmt.Sprintf("%x", b)
 }
 
 // Digit returns a 32 bytes UUID
 func (u UUID) Digit(ctx context.Context, v reflect.Value) (interface{}, error) {
-	return u.digit()
+	return u.digit(RandFromContext(ctx)), nil
 }
 
 // UUIDDigit get fake Digit UUID
 func UUIDDigit() string {
 	u := UUID{}
-	res, _ := u.digit()
-	return res
+	return u.digit(globalRand)
 }